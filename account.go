@@ -1,7 +1,6 @@
 package quickbooks
 
 import (
-	"encoding/json"
 	"errors"
 	"strconv"
 )
@@ -31,26 +30,46 @@ type Account struct {
 	AcctNum                       string        `json:",omitempty"`
 	CurrencyRef                   ReferenceType `json:",omitempty"`
 	ParentRef                     ReferenceType `json:",omitempty"`
-	Description                   string        `json:",omitempty"`
-	Active                        bool          `json:",omitempty"`
+	Description                   *string       `json:",omitempty"`
+	Active                        *bool         `json:",omitempty"`
 	MetaData                      MetaData      `json:",omitempty"`
-	SubAccount                    bool          `json:",omitempty"`
+	SubAccount                    *bool         `json:",omitempty"`
 	Classification                string        `json:",omitempty"`
 	FullyQualifiedName            string        `json:",omitempty"`
 	TxnLocationType               string        `json:",omitempty"`
 	AccountType                   string        `json:",omitempty"`
-	CurrentBalanceWithSubAccounts json.Number   `json:",omitempty"`
+	CurrentBalanceWithSubAccounts Decimal       `json:",omitempty"`
 	AccountAlias                  string        `json:",omitempty"`
 	TaxCodeRef                    ReferenceType `json:",omitempty"`
 	AccountSubType                string        `json:",omitempty"`
-	CurrentBalance                json.Number   `json:",omitempty"`
+	CurrentBalance                Decimal       `json:",omitempty"`
+}
+
+// IsBalanceSheet reports whether the account is an asset, liability or equity
+// account, rather than an income or expense account.
+func (a *Account) IsBalanceSheet() bool {
+	switch a.AccountType {
+	case BankAccountType,
+		OtherCurrentAssetAccountType,
+		FixedAssetAccountType,
+		OtherAssetAccountType,
+		AccountsReceivableAccountType,
+		EquityAccountType,
+		AccountsPayableAccountType,
+		CreditCardAccountType,
+		LongTermLiabilityAccountType,
+		OtherCurrentLiabilityAccountType:
+		return true
+	}
+
+	return false
 }
 
 // CreateAccount creates the given account within QuickBooks
 func (c *Client) CreateAccount(account *Account) (*Account, error) {
 	var resp struct {
 		Account Account
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.post("account", account, &resp, nil); err != nil {
@@ -102,7 +121,7 @@ func (c *Client) FindAccounts() ([]Account, error) {
 func (c *Client) FindAccountById(id string) (*Account, error) {
 	var resp struct {
 		Account Account
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.get("account/"+id, &resp, nil); err != nil {
@@ -134,34 +153,10 @@ func (c *Client) QueryAccounts(query string) ([]Account, error) {
 }
 
 // UpdateAccount updates the account
-func (c *Client) UpdateAccount(account *Account) (*Account, error) {
+func (c *Client) UpdateAccount(account *Account, opts ...UpdateOption) (*Account, error) {
 	if account.Id == "" {
 		return nil, errors.New("missing account id")
 	}
 
-	existingAccount, err := c.FindAccountById(account.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	account.SyncToken = existingAccount.SyncToken
-
-	payload := struct {
-		*Account
-		Sparse bool `json:"sparse"`
-	}{
-		Account: account,
-		Sparse:  true,
-	}
-
-	var accountData struct {
-		Account Account
-		Time    Date
-	}
-
-	if err = c.post("account", payload, &accountData, nil); err != nil {
-		return nil, err
-	}
-
-	return &accountData.Account, err
+	return update(c, "account", "Account", account, c.FindAccountById, opts)
 }
@@ -20,7 +20,7 @@ func TestAccount(t *testing.T) {
 
 	var r struct {
 		Account Account
-		Time    Date
+		Time    DateTime
 	}
 	err = json.Unmarshal(byteValue, &r)
 	require.NoError(t, err)
@@ -29,13 +29,22 @@ func TestAccount(t *testing.T) {
 	assert.Equal(t, "MyJobs", r.Account.Name)
 	assert.Equal(t, "Asset", r.Account.Classification)
 	assert.Equal(t, "AccountsReceivable", r.Account.AccountSubType)
-	assert.Equal(t, json.Number("0"), r.Account.CurrentBalanceWithSubAccounts)
+	assert.Equal(t, Decimal("0"), r.Account.CurrentBalanceWithSubAccounts)
 	assert.Equal(t, "2014-12-31T09:29:05-08:00", r.Account.MetaData.CreateTime.String())
 	assert.Equal(t, "2014-12-31T09:29:05-08:00", r.Account.MetaData.LastUpdatedTime.String())
 	assert.Equal(t, AccountsReceivableAccountType, r.Account.AccountType)
-	assert.Equal(t, json.Number("0"), r.Account.CurrentBalance)
-	assert.True(t, r.Account.Active)
+	assert.Equal(t, Decimal("0"), r.Account.CurrentBalance)
+	assert.Equal(t, Bool(true), r.Account.Active)
 	assert.Equal(t, "0", r.Account.SyncToken)
 	assert.Equal(t, "94", r.Account.Id)
-	assert.False(t, r.Account.SubAccount)
+	assert.Equal(t, Bool(false), r.Account.SubAccount)
+}
+
+func TestAccountIsBalanceSheet(t *testing.T) {
+	assert.True(t, (&Account{AccountType: BankAccountType}).IsBalanceSheet())
+	assert.True(t, (&Account{AccountType: CreditCardAccountType}).IsBalanceSheet())
+	assert.True(t, (&Account{AccountType: EquityAccountType}).IsBalanceSheet())
+	assert.False(t, (&Account{AccountType: IncomeAccountType}).IsBalanceSheet())
+	assert.False(t, (&Account{AccountType: CostOfGoodsSoldAccountType}).IsBalanceSheet())
+	assert.False(t, (&Account{}).IsBalanceSheet())
 }
@@ -40,7 +40,7 @@ type Attachable struct {
 	Id                       string          `json:"Id,omitempty"`
 	SyncToken                string          `json:",omitempty"`
 	FileName                 string          `json:",omitempty"`
-	Note                     string          `json:",omitempty"`
+	Note                     *string         `json:",omitempty"`
 	Category                 string          `json:",omitempty"`
 	ContentType              ContentType     `json:",omitempty"`
 	PlaceName                string          `json:",omitempty"`
@@ -57,11 +57,11 @@ type Attachable struct {
 }
 
 type AttachableRef struct {
-	IncludeOnSend bool   `json:",omitempty"`
+	IncludeOnSend *bool  `json:",omitempty"`
 	LineInfo      string `json:",omitempty"`
-	NoRefOnly     bool   `json:",omitempty"`
+	NoRefOnly     *bool  `json:",omitempty"`
 	// CustomField[0..n]
-	Inactive  bool          `json:",omitempty"`
+	Inactive  *bool         `json:",omitempty"`
 	EntityRef ReferenceType `json:",omitempty"`
 }
 
@@ -70,7 +70,7 @@ type AttachableRef struct {
 func (c *Client) CreateAttachable(attachable *Attachable) (*Attachable, error) {
 	var resp struct {
 		Attachable Attachable
-		Time       Date
+		Time       DateTime
 	}
 
 	if err := c.post("attachable", attachable, &resp, nil); err != nil {
@@ -164,7 +164,7 @@ func (c *Client) FindAttachables() ([]Attachable, error) {
 func (c *Client) FindAttachableById(id string) (*Attachable, error) {
 	var resp struct {
 		Attachable Attachable
-		Time       Date
+		Time       DateTime
 	}
 
 	if err := c.get("attachable/"+id, &resp, nil); err != nil {
@@ -196,36 +196,12 @@ func (c *Client) QueryAttachables(query string) ([]Attachable, error) {
 }
 
 // UpdateAttachable updates the attachable
-func (c *Client) UpdateAttachable(attachable *Attachable) (*Attachable, error) {
+func (c *Client) UpdateAttachable(attachable *Attachable, opts ...UpdateOption) (*Attachable, error) {
 	if attachable.Id == "" {
 		return nil, errors.New("missing attachable id")
 	}
 
-	existingAttachable, err := c.FindAttachableById(attachable.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	attachable.SyncToken = existingAttachable.SyncToken
-
-	payload := struct {
-		*Attachable
-		Sparse bool `json:"sparse"`
-	}{
-		Attachable: attachable,
-		Sparse:     true,
-	}
-
-	var attachableData struct {
-		Attachable Attachable
-		Time       Date
-	}
-
-	if err = c.post("attachable", payload, &attachableData, nil); err != nil {
-		return nil, err
-	}
-
-	return &attachableData.Attachable, err
+	return update(c, "attachable", "Attachable", attachable, c.FindAttachableById, opts)
 }
 
 // UploadAttachable uploads the attachable
@@ -298,7 +274,7 @@ func (c *Client) UploadAttachable(attachable *Attachable, data io.Reader) (*Atta
 		AttachableResponse []struct {
 			Attachable Attachable
 		}
-		Time Date
+		Time DateTime
 	}
 
 	if err = json.NewDecoder(resp.Body).Decode(&r); err != nil {
@@ -1,7 +1,6 @@
 package quickbooks
 
 import (
-	"encoding/json"
 	"errors"
 	"strconv"
 )
@@ -12,24 +11,38 @@ type Bill struct {
 	Line         []Line
 	SyncToken    string        `json:",omitempty"`
 	CurrencyRef  ReferenceType `json:",omitempty"`
-	TxnDate      Date          `json:",omitempty"`
+	TxnDate      *Date         `json:",omitempty"`
 	APAccountRef ReferenceType `json:",omitempty"`
 	SalesTermRef ReferenceType `json:",omitempty"`
 	LinkedTxn    []LinkedTxn   `json:",omitempty"`
 	// GlobalTaxCalculation
-	TotalAmt                json.Number `json:",omitempty"`
-	TransactionLocationType string      `json:",omitempty"`
-	DueDate                 Date        `json:",omitempty"`
-	MetaData                MetaData    `json:",omitempty"`
+	TotalAmt                Decimal  `json:",omitempty"`
+	TransactionLocationType string   `json:",omitempty"`
+	DueDate                 *Date    `json:",omitempty"`
+	MetaData                MetaData `json:",omitempty"`
 	DocNumber               string
-	PrivateNote             string        `json:",omitempty"`
-	TxnTaxDetail            TxnTaxDetail  `json:",omitempty"`
-	ExchangeRate            json.Number   `json:",omitempty"`
-	DepartmentRef           ReferenceType `json:",omitempty"`
-	IncludeInAnnualTPAR     bool          `json:",omitempty"`
-	HomeBalance             json.Number   `json:",omitempty"`
-	RecurDataRef            ReferenceType `json:",omitempty"`
-	Balance                 json.Number   `json:",omitempty"`
+	PrivateNote             *string        `json:",omitempty"`
+	TxnTaxDetail            TxnTaxDetail   `json:",omitempty"`
+	ExchangeRate            Decimal        `json:",omitempty"`
+	DepartmentRef           ReferenceType  `json:",omitempty"`
+	IncludeInAnnualTPAR     *bool          `json:",omitempty"`
+	HomeBalance             Decimal        `json:",omitempty"`
+	RecurDataRef            ReferenceType  `json:",omitempty"`
+	Balance                 Decimal        `json:",omitempty"`
+	RecurringInfo           *RecurringInfo `json:",omitempty"`
+}
+
+// SetHomeBalance sets HomeBalance from the bill's lines, tax, Balance and
+// ExchangeRate, as QuickBooks would. See TransactionHomeCurrencyAmounts.
+func (b *Bill) SetHomeBalance() error {
+	amounts, err := TransactionHomeCurrencyAmounts(b.Line, b.TxnTaxDetail.TotalTax, b.Balance, b.ExchangeRate)
+	if err != nil {
+		return err
+	}
+
+	b.HomeBalance = amounts.Balance
+
+	return nil
 }
 
 // CreateBill creates the given Bill on the QuickBooks server, returning
@@ -37,7 +50,7 @@ type Bill struct {
 func (c *Client) CreateBill(bill *Bill) (*Bill, error) {
 	var resp struct {
 		Bill Bill
-		Time Date
+		Time DateTime
 	}
 
 	if err := c.post("bill", bill, &resp, nil); err != nil {
@@ -98,7 +111,7 @@ func (c *Client) FindBills() ([]Bill, error) {
 func (c *Client) FindBillById(id string) (*Bill, error) {
 	var resp struct {
 		Bill Bill
-		Time Date
+		Time DateTime
 	}
 
 	if err := c.get("bill/"+id, &resp, nil); err != nil {
@@ -130,34 +143,10 @@ func (c *Client) QueryBills(query string) ([]Bill, error) {
 }
 
 // UpdateBill updates the bill
-func (c *Client) UpdateBill(bill *Bill) (*Bill, error) {
+func (c *Client) UpdateBill(bill *Bill, opts ...UpdateOption) (*Bill, error) {
 	if bill.Id == "" {
 		return nil, errors.New("missing bill id")
 	}
 
-	existingBill, err := c.FindBillById(bill.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	bill.SyncToken = existingBill.SyncToken
-
-	payload := struct {
-		*Bill
-		Sparse bool `json:"sparse"`
-	}{
-		Bill:   bill,
-		Sparse: true,
-	}
-
-	var billData struct {
-		Bill Bill
-		Time Date
-	}
-
-	if err = c.post("bill", payload, &billData, nil); err != nil {
-		return nil, err
-	}
-
-	return &billData.Bill, err
+	return update(c, "bill", "Bill", bill, c.FindBillById, opts)
 }
@@ -0,0 +1,244 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+const (
+	BillPaymentCheckType      = "Check"
+	BillPaymentCreditCardType = "CreditCard"
+)
+
+// BillPayment represents a payment made against one or more vendor bills.
+type BillPayment struct {
+	Id                string                 `json:"Id,omitempty"`
+	SyncToken         string                 `json:",omitempty"`
+	MetaData          MetaData               `json:",omitempty"`
+	DocNumber         string                 `json:",omitempty"`
+	TxnDate           *Date                  `json:",omitempty"`
+	PrivateNote       *string                `json:",omitempty"`
+	VendorRef         ReferenceType          `json:",omitempty"`
+	APAccountRef      ReferenceType          `json:",omitempty"`
+	DepartmentRef     ReferenceType          `json:",omitempty"`
+	CurrencyRef       ReferenceType          `json:",omitempty"`
+	ExchangeRate      Decimal                `json:",omitempty"`
+	PayType           string                 `json:",omitempty"`
+	CheckPayment      *BillPaymentCheck      `json:",omitempty"`
+	CreditCardPayment *BillPaymentCreditCard `json:",omitempty"`
+	Line              []BillPaymentLine      `json:",omitempty"`
+	TotalAmt          Decimal                `json:",omitempty"`
+}
+
+// BillPaymentCheck holds the details of a bill payment made by check.
+type BillPaymentCheck struct {
+	BankAccountRef ReferenceType    `json:",omitempty"`
+	PrintStatus    string           `json:",omitempty"`
+	PayeeAddr      *PhysicalAddress `json:",omitempty"`
+}
+
+// BillPaymentCreditCard holds the details of a bill payment made by credit card.
+type BillPaymentCreditCard struct {
+	CCAccountRef ReferenceType `json:",omitempty"`
+}
+
+// BillPaymentLine links an amount of the payment to a bill or vendor credit.
+type BillPaymentLine struct {
+	Amount    Decimal
+	LinkedTxn []LinkedTxn `json:",omitempty"`
+}
+
+// CreateBillPayment creates the given BillPayment on the QuickBooks server,
+// returning the resulting BillPayment object.
+func (c *Client) CreateBillPayment(billPayment *BillPayment) (*BillPayment, error) {
+	var resp struct {
+		BillPayment BillPayment
+		Time        DateTime
+	}
+
+	if err := c.post("billpayment", billPayment, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.BillPayment, nil
+}
+
+// DeleteBillPayment deletes the bill payment
+func (c *Client) DeleteBillPayment(billPayment *BillPayment) error {
+	if billPayment.Id == "" || billPayment.SyncToken == "" {
+		return errors.New("missing id/sync token")
+	}
+
+	return c.post("billpayment", billPayment, nil, map[string]string{"operation": "delete"})
+}
+
+// FindBillPayments gets the full list of BillPayments in the QuickBooks account.
+func (c *Client) FindBillPayments() ([]BillPayment, error) {
+	var resp struct {
+		QueryResponse struct {
+			BillPayments  []BillPayment `json:"BillPayment"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM BillPayment", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no bill payments could be found")
+	}
+
+	billPayments := make([]BillPayment, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM BillPayment ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.BillPayments == nil {
+			return nil, errors.New("no bill payments could be found")
+		}
+
+		billPayments = append(billPayments, resp.QueryResponse.BillPayments...)
+	}
+
+	return billPayments, nil
+}
+
+// FindBillPaymentById finds the bill payment by the given id
+func (c *Client) FindBillPaymentById(id string) (*BillPayment, error) {
+	var resp struct {
+		BillPayment BillPayment
+		Time        DateTime
+	}
+
+	if err := c.get("billpayment/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.BillPayment, nil
+}
+
+// QueryBillPayments accepts an SQL query and returns all bill payments found using it
+func (c *Client) QueryBillPayments(query string) ([]BillPayment, error) {
+	var resp struct {
+		QueryResponse struct {
+			BillPayments  []BillPayment `json:"BillPayment"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.BillPayments == nil {
+		return nil, errors.New("could not find any bill payments")
+	}
+
+	return resp.QueryResponse.BillPayments, nil
+}
+
+// UpdateBillPayment updates the bill payment
+func (c *Client) UpdateBillPayment(billPayment *BillPayment, opts ...UpdateOption) (*BillPayment, error) {
+	if billPayment.Id == "" {
+		return nil, errors.New("missing bill payment id")
+	}
+
+	return update(c, "billpayment", "BillPayment", billPayment, c.FindBillPaymentById, opts)
+}
+
+// VoidBillPayment voids the given bill payment in QuickBooks.
+func (c *Client) VoidBillPayment(billPayment BillPayment) error {
+	if billPayment.Id == "" {
+		return errors.New("missing bill payment id")
+	}
+
+	existingBillPayment, err := c.FindBillPaymentById(billPayment.Id)
+	if err != nil {
+		return err
+	}
+
+	billPayment.SyncToken = existingBillPayment.SyncToken
+
+	return c.post("billpayment", billPayment, nil, map[string]string{"operation": "update", "include": "void"})
+}
+
+// PayBills pays the open balance of each of the given bills from account,
+// which must be a bank account (paid by check) or a credit card account. Bills
+// without an open balance are skipped.
+func (c *Client) PayBills(vendor *Vendor, bills []Bill, account *Account) (*BillPayment, error) {
+	billPayment, err := newBillPayment(vendor, bills, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateBillPayment(billPayment)
+}
+
+// newBillPayment builds the bill payment that PayBills posts. TxnDate is left
+// unset so that QuickBooks dates it today in the company's time zone.
+func newBillPayment(vendor *Vendor, bills []Bill, account *Account) (*BillPayment, error) {
+	if vendor.Id == "" {
+		return nil, errors.New("missing vendor id")
+	}
+
+	if account.Id == "" {
+		return nil, errors.New("missing account id")
+	}
+
+	billPayment := BillPayment{
+		VendorRef: ReferenceType{Value: vendor.Id, Name: vendor.DisplayName},
+	}
+
+	accountRef := ReferenceType{Value: account.Id, Name: account.Name}
+
+	switch account.AccountType {
+	case BankAccountType:
+		billPayment.PayType = BillPaymentCheckType
+		billPayment.CheckPayment = &BillPaymentCheck{BankAccountRef: accountRef}
+	case CreditCardAccountType:
+		billPayment.PayType = BillPaymentCreditCardType
+		billPayment.CreditCardPayment = &BillPaymentCreditCard{CCAccountRef: accountRef}
+	default:
+		return nil, errors.New("bills can only be paid from a bank or credit card account")
+	}
+
+	var total Decimal
+
+	for _, bill := range bills {
+		if bill.Id == "" {
+			return nil, errors.New("missing bill id")
+		}
+
+		if bill.VendorRef.Value != vendor.Id {
+			return nil, errors.New("bill " + bill.Id + " belongs to a different vendor")
+		}
+
+		if bill.Balance.Sign() <= 0 {
+			continue
+		}
+
+		balance := bill.Balance.RoundCurrency()
+
+		billPayment.Line = append(billPayment.Line, BillPaymentLine{
+			Amount:    balance,
+			LinkedTxn: []LinkedTxn{{TxnID: bill.Id, TxnType: "Bill"}},
+		})
+		total = total.Add(balance)
+	}
+
+	if len(billPayment.Line) == 0 {
+		return nil, errors.New("no bills with an open balance")
+	}
+
+	billPayment.TotalAmt = total.RoundCurrency()
+
+	return &billPayment, nil
+}
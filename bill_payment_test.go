@@ -0,0 +1,86 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBillPayment(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/bill_payment.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		BillPayment BillPayment
+		Time        DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "236", r.BillPayment.Id)
+	assert.Equal(t, "56", r.BillPayment.VendorRef.Value)
+	assert.Equal(t, BillPaymentCreditCardType, r.BillPayment.PayType)
+	assert.Nil(t, r.BillPayment.CheckPayment)
+	require.NotNil(t, r.BillPayment.CreditCardPayment)
+	assert.Equal(t, "41", r.BillPayment.CreditCardPayment.CCAccountRef.Value)
+	assert.Equal(t, Decimal("200.0"), r.BillPayment.TotalAmt)
+	require.Len(t, r.BillPayment.Line, 1)
+	assert.Equal(t, "234", r.BillPayment.Line[0].LinkedTxn[0].TxnID)
+	assert.Equal(t, "Bill", r.BillPayment.Line[0].LinkedTxn[0].TxnType)
+}
+
+func TestNewBillPayment(t *testing.T) {
+	vendor := &Vendor{Id: "56", DisplayName: "Bob's Burger Joint"}
+	bills := []Bill{
+		{Id: "234", VendorRef: ReferenceType{Value: "56"}, Balance: "200"},
+		{Id: "235", VendorRef: ReferenceType{Value: "56"}, Balance: "0"},
+		{Id: "237", VendorRef: ReferenceType{Value: "56"}, Balance: "12.34"},
+	}
+
+	billPayment, err := newBillPayment(vendor, bills, &Account{Id: "35", AccountType: BankAccountType})
+	require.NoError(t, err)
+	assert.Equal(t, BillPaymentCheckType, billPayment.PayType)
+	assert.Equal(t, "35", billPayment.CheckPayment.BankAccountRef.Value)
+	assert.Nil(t, billPayment.CreditCardPayment)
+	assert.Nil(t, billPayment.TxnDate)
+	assert.Equal(t, Decimal("212.34"), billPayment.TotalAmt)
+	require.Len(t, billPayment.Line, 2)
+	assert.Equal(t, Decimal("200.00"), billPayment.Line[0].Amount)
+	assert.Equal(t, Decimal("12.34"), billPayment.Line[1].Amount)
+
+	billPayment, err = newBillPayment(vendor, bills, &Account{Id: "41", AccountType: CreditCardAccountType})
+	require.NoError(t, err)
+	assert.Equal(t, BillPaymentCreditCardType, billPayment.PayType)
+	assert.Equal(t, "41", billPayment.CreditCardPayment.CCAccountRef.Value)
+
+	_, err = newBillPayment(vendor, bills, &Account{Id: "64", AccountType: ExpenseAccountType})
+	assert.Error(t, err)
+}
+
+func TestUpdateBillPaymentSparse(t *testing.T) {
+	var posted map[string]json.RawMessage
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &posted)
+		}
+		w.Write([]byte(`{"BillPayment": {"Id": "236", "SyncToken": "2", "TotalAmt": 200.0}}`))
+	}))
+
+	_, err := client.UpdateBillPayment(&BillPayment{Id: "236", PrivateNote: String("Paid early")})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `"2"`, string(posted["SyncToken"]))
+	assert.JSONEq(t, `"Paid early"`, string(posted["PrivateNote"]))
+	assert.NotContains(t, posted, "TotalAmt")
+}
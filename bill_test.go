@@ -24,7 +24,7 @@ func TestBill(t *testing.T) {
 
 	var r struct {
 		Bill Bill
-		Time Date
+		Time DateTime
 	}
 	err = json.Unmarshal(byteValue, &r)
 	if err != nil {
@@ -35,18 +35,53 @@ func TestBill(t *testing.T) {
 	assert.Equal(t, "33", r.Bill.APAccountRef.Value)
 	assert.Equal(t, "Norton Lumber and Building Materials", r.Bill.VendorRef.Name)
 	assert.Equal(t, "46", r.Bill.VendorRef.Value)
-	assert.Equal(t, "2014-11-06T00:00:00+00:00", r.Bill.TxnDate.String())
+	assert.Equal(t, "2014-11-06", r.Bill.TxnDate.String())
 	totalAmt, _ := r.Bill.TotalAmt.Float64()
 	assert.Equal(t, 103.55, totalAmt)
 	assert.Equal(t, "United States Dollar", r.Bill.CurrencyRef.Name)
 	assert.Equal(t, "USD", r.Bill.CurrencyRef.Value)
 	// LinkedTxn
 	assert.Equal(t, "3", r.Bill.SalesTermRef.Value)
-	assert.Equal(t, "2014-12-06T00:00:00+00:00", r.Bill.DueDate.String())
+	assert.Equal(t, "2014-12-06", r.Bill.DueDate.String())
 	assert.Equal(t, 1, len(r.Bill.Line))
+	detail := r.Bill.Line[0].AccountBasedExpenseLineDetail
+	assert.Equal(t, "64", detail.AccountRef.Value)
+	assert.Equal(t, "TAX", detail.TaxCodeRef.Value)
+	assert.Equal(t, BillableStatusBillable, detail.BillableStatus)
+	assert.Equal(t, "26", detail.CustomerRef.Value)
 	balance, _ := r.Bill.Balance.Int64()
 	assert.Equal(t, int64(0), balance)
 	assert.Equal(t, "25", r.Bill.Id)
 	assert.Equal(t, "2014-11-06T15:37:25-08:00", r.Bill.MetaData.CreateTime.String())
 	assert.Equal(t, "2015-02-09T10:11:11-08:00", r.Bill.MetaData.LastUpdatedTime.String())
 }
+
+func TestBillItemBasedExpenseLine(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/bill_item_expense.json")
+	if err != nil {
+		log.Fatal("When opening JSON file: ", err)
+	}
+	defer jsonFile.Close()
+
+	byteValue, _ := ioutil.ReadAll(jsonFile)
+
+	var r struct {
+		Bill Bill
+		Time DateTime
+	}
+	err = json.Unmarshal(byteValue, &r)
+	if err != nil {
+		log.Fatal("When decoding JSON file: ", err)
+	}
+	assert.Equal(t, 1, len(r.Bill.Line))
+	assert.Equal(t, ItemBasedExpenseLineDetailType, r.Bill.Line[0].DetailType)
+	detail := r.Bill.Line[0].ItemBasedExpenseLineDetail
+	assert.Equal(t, "11", detail.ItemRef.Value)
+	assert.Equal(t, Decimal("8"), detail.Qty)
+	assert.Equal(t, Decimal("12.50"), detail.UnitPrice)
+	assert.Equal(t, "58", detail.CustomerRef.Value)
+	assert.Equal(t, BillableStatusBillable, detail.BillableStatus)
+	assert.Equal(t, Decimal("15"), detail.MarkupInfo.Percent)
+	assert.Equal(t, "83", detail.MarkupInfo.MarkUpIncomeAccountRef.Value)
+	assert.Nil(t, detail.ClassRef)
+}
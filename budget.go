@@ -0,0 +1,167 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Budget represents a QuickBooks budget. Budgets are read-only through the API.
+type Budget struct {
+	Id              string         `json:"Id,omitempty"`
+	SyncToken       string         `json:",omitempty"`
+	MetaData        MetaData       `json:",omitempty"`
+	Name            string         `json:",omitempty"`
+	StartDate       Date           `json:",omitempty"`
+	EndDate         Date           `json:",omitempty"`
+	BudgetType      string         `json:",omitempty"`
+	BudgetEntryType string         `json:",omitempty"`
+	Active          bool           `json:",omitempty"`
+	BudgetDetail    []BudgetDetail `json:",omitempty"`
+}
+
+// BudgetDetail is the amount budgeted for an account, optionally split by
+// customer, class or department, in the period starting on BudgetDate.
+type BudgetDetail struct {
+	BudgetDate    Date          `json:",omitempty"`
+	Amount        Decimal       `json:",omitempty"`
+	AccountRef    ReferenceType `json:",omitempty"`
+	CustomerRef   ReferenceType `json:",omitempty"`
+	ClassRef      ReferenceType `json:",omitempty"`
+	DepartmentRef ReferenceType `json:",omitempty"`
+}
+
+// BudgetAccountSeries is the amount budgeted for one account in each month of
+// a budget. Months holds the first day of each month, and Amounts the total
+// for the corresponding month.
+type BudgetAccountSeries struct {
+	AccountRef ReferenceType
+	Months     []Date
+	Amounts    []Decimal
+}
+
+// MonthlySeries pivots the budget details into one series per account, in
+// the order the accounts first appear. Amounts split by customer, class or
+// department are added together, and each detail is counted in the month of
+// its BudgetDate.
+func (b *Budget) MonthlySeries() ([]BudgetAccountSeries, error) {
+	start := time.Date(b.StartDate.Year(), b.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(b.EndDate.Year(), b.EndDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	if end.Before(start) {
+		return nil, errors.New("budget ends before it starts")
+	}
+
+	var months []Date
+	for month := start; !month.After(end); month = month.AddDate(0, 1, 0) {
+		months = append(months, Date{month})
+	}
+
+	var accounts []ReferenceType
+	totals := make(map[string][]Decimal)
+
+	for _, budgetDetail := range b.BudgetDetail {
+		date := budgetDetail.BudgetDate
+		index := (date.Year()-start.Year())*12 + int(date.Month()-start.Month())
+
+		if index < 0 || index >= len(months) {
+			return nil, errors.New("budget detail dated " + date.Format(secondFormat) + " is outside the budget")
+		}
+
+		id := budgetDetail.AccountRef.Value
+		if _, ok := totals[id]; !ok {
+			accounts = append(accounts, budgetDetail.AccountRef)
+			totals[id] = make([]Decimal, len(months))
+		}
+
+		totals[id][index] = totals[id][index].Add(budgetDetail.Amount)
+	}
+
+	series := make([]BudgetAccountSeries, 0, len(accounts))
+
+	for _, account := range accounts {
+		amounts := make([]Decimal, len(months))
+		for i, total := range totals[account.Value] {
+			amounts[i] = total.RoundCurrency()
+		}
+
+		series = append(series, BudgetAccountSeries{
+			AccountRef: account,
+			Months:     months,
+			Amounts:    amounts,
+		})
+	}
+
+	return series, nil
+}
+
+// FindBudgets gets the full list of Budgets in the QuickBooks account.
+func (c *Client) FindBudgets() ([]Budget, error) {
+	var resp struct {
+		QueryResponse struct {
+			Budgets       []Budget `json:"Budget"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM Budget", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no budgets could be found")
+	}
+
+	budgets := make([]Budget, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM Budget ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.Budgets == nil {
+			return nil, errors.New("no budgets could be found")
+		}
+
+		budgets = append(budgets, resp.QueryResponse.Budgets...)
+	}
+
+	return budgets, nil
+}
+
+// FindBudgetById finds the budget by the given id. Budgets can only be read
+// through a query.
+func (c *Client) FindBudgetById(id string) (*Budget, error) {
+	budgets, err := c.QueryBudgets("SELECT * FROM Budget WHERE Id = '" + strings.Replace(id, "'", "''", -1) + "'")
+	if err != nil {
+		return nil, err
+	}
+
+	return &budgets[0], nil
+}
+
+// QueryBudgets accepts an SQL query and returns all budgets found using it
+func (c *Client) QueryBudgets(query string) ([]Budget, error) {
+	var resp struct {
+		QueryResponse struct {
+			Budgets       []Budget `json:"Budget"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.Budgets == nil {
+		return nil, errors.New("could not find any budgets")
+	}
+
+	return resp.QueryResponse.Budgets, nil
+}
@@ -0,0 +1,53 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/budget.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		QueryResponse struct {
+			Budgets []Budget `json:"Budget"`
+		}
+		Time DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+	require.Len(t, r.QueryResponse.Budgets, 1)
+
+	budget := r.QueryResponse.Budgets[0]
+	assert.Equal(t, "1", budget.Id)
+	assert.Equal(t, "ProfitAndLoss", budget.BudgetType)
+	assert.Equal(t, "Monthly", budget.BudgetEntryType)
+	require.Len(t, budget.BudgetDetail, 4)
+	assert.Equal(t, "5000000000000007280", budget.BudgetDetail[1].ClassRef.Value)
+
+	series, err := budget.MonthlySeries()
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+
+	assert.Equal(t, "79", series[0].AccountRef.Value)
+	require.Len(t, series[0].Months, 3)
+	assert.Equal(t, "2016-02-01", series[0].Months[1].Format("2006-01-02"))
+	assert.Equal(t, []Decimal{"2000.00", "0.00", "2500.00"}, series[0].Amounts)
+
+	assert.Equal(t, "7", series[1].AccountRef.Value)
+	assert.Equal(t, []Decimal{"0.00", "300.00", "0.00"}, series[1].Amounts)
+
+	budget.BudgetDetail[0].BudgetDate = Date{budget.EndDate.AddDate(0, 1, 0)}
+	_, err = budget.MonthlySeries()
+	assert.Error(t, err)
+}
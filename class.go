@@ -0,0 +1,189 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Class represents a QuickBooks Class, used to segment transactions for reporting.
+type Class struct {
+	Id                 string         `json:"Id,omitempty"`
+	SyncToken          string         `json:",omitempty"`
+	MetaData           MetaData       `json:",omitempty"`
+	Name               string         `json:",omitempty"`
+	SubClass           *bool          `json:",omitempty"`
+	ParentRef          *ReferenceType `json:",omitempty"`
+	FullyQualifiedName string         `json:",omitempty"`
+	Active             *bool          `json:",omitempty"`
+}
+
+// ClassNode is a class together with its direct children in the hierarchy.
+type ClassNode struct {
+	Class    Class
+	Children []*ClassNode
+}
+
+// BuildClassTree arranges the given classes into a tree following their
+// ParentRef, returning the top-level classes in the order given. A class whose
+// parent isn't in the list, or whose parent would make a cycle, is treated as
+// top-level.
+func BuildClassTree(classes []Class) []*ClassNode {
+	nodes := make([]*ClassNode, len(classes))
+	ids := make([]string, len(classes))
+	parentIds := make([]string, len(classes))
+
+	for i := range classes {
+		nodes[i] = &ClassNode{Class: classes[i]}
+		ids[i] = classes[i].Id
+		if classes[i].ParentRef != nil {
+			parentIds[i] = classes[i].ParentRef.Value
+		}
+	}
+
+	var roots []*ClassNode
+
+	for i, parent := range treeParents(ids, parentIds) {
+		if parent < 0 {
+			roots = append(roots, nodes[i])
+			continue
+		}
+
+		nodes[parent].Children = append(nodes[parent].Children, nodes[i])
+	}
+
+	return roots
+}
+
+// CreateClass creates the given Class on the QuickBooks server, returning the
+// resulting Class object.
+func (c *Client) CreateClass(class *Class) (*Class, error) {
+	var resp struct {
+		Class Class
+		Time  DateTime
+	}
+
+	if err := c.post("class", class, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Class, nil
+}
+
+// DeactivateClass marks the class as inactive. QuickBooks doesn't allow classes
+// to be deleted.
+func (c *Client) DeactivateClass(class *Class) (*Class, error) {
+	if class.Id == "" {
+		return nil, errors.New("missing class id")
+	}
+
+	existingClass, err := c.FindClassById(class.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		*Class
+		Sparse bool `json:"sparse"`
+	}{
+		Class: &Class{
+			Id:        existingClass.Id,
+			SyncToken: existingClass.SyncToken,
+			Name:      existingClass.Name,
+			Active:    Bool(false),
+		},
+		Sparse: true,
+	}
+
+	var classData struct {
+		Class Class
+		Time  DateTime
+	}
+
+	if err = c.post("class", payload, &classData, nil); err != nil {
+		return nil, err
+	}
+
+	return &classData.Class, err
+}
+
+// FindClasses gets the full list of Classes in the QuickBooks account.
+func (c *Client) FindClasses() ([]Class, error) {
+	var resp struct {
+		QueryResponse struct {
+			Classes       []Class `json:"Class"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM Class", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no classes could be found")
+	}
+
+	classes := make([]Class, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM Class ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.Classes == nil {
+			return nil, errors.New("no classes could be found")
+		}
+
+		classes = append(classes, resp.QueryResponse.Classes...)
+	}
+
+	return classes, nil
+}
+
+// FindClassById finds the class by the given id
+func (c *Client) FindClassById(id string) (*Class, error) {
+	var resp struct {
+		Class Class
+		Time  DateTime
+	}
+
+	if err := c.get("class/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Class, nil
+}
+
+// QueryClasses accepts an SQL query and returns all classes found using it
+func (c *Client) QueryClasses(query string) ([]Class, error) {
+	var resp struct {
+		QueryResponse struct {
+			Classes       []Class `json:"Class"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.Classes == nil {
+		return nil, errors.New("could not find any classes")
+	}
+
+	return resp.QueryResponse.Classes, nil
+}
+
+// UpdateClass updates the class
+func (c *Client) UpdateClass(class *Class, opts ...UpdateOption) (*Class, error) {
+	if class.Id == "" {
+		return nil, errors.New("missing class id")
+	}
+
+	return update(c, "class", "Class", class, c.FindClassById, opts)
+}
@@ -0,0 +1,73 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClass(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/class.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Class Class
+		Time  DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "5000000000000007280", r.Class.Id)
+	assert.Equal(t, "Bordeaux", r.Class.Name)
+	assert.Equal(t, "France:Bordeaux", r.Class.FullyQualifiedName)
+	assert.Equal(t, Bool(true), r.Class.SubClass)
+	require.NotNil(t, r.Class.ParentRef)
+	assert.Equal(t, "5000000000000007273", r.Class.ParentRef.Value)
+	assert.Equal(t, Bool(true), r.Class.Active)
+}
+
+func TestBuildClassTree(t *testing.T) {
+	classes := []Class{
+		{Id: "3", Name: "Bordeaux", SubClass: Bool(true), ParentRef: &ReferenceType{Value: "1"}},
+		{Id: "1", Name: "France"},
+		{Id: "4", Name: "Pauillac", SubClass: Bool(true), ParentRef: &ReferenceType{Value: "3"}},
+		{Id: "2", Name: "Spain"},
+		{Id: "5", Name: "Orphan", SubClass: Bool(true), ParentRef: &ReferenceType{Value: "99"}},
+	}
+
+	roots := BuildClassTree(classes)
+	require.Len(t, roots, 3)
+	assert.Equal(t, "France", roots[0].Class.Name)
+	assert.Equal(t, "Spain", roots[1].Class.Name)
+	assert.Equal(t, "Orphan", roots[2].Class.Name)
+
+	require.Len(t, roots[0].Children, 1)
+	assert.Equal(t, "Bordeaux", roots[0].Children[0].Class.Name)
+	require.Len(t, roots[0].Children[0].Children, 1)
+	assert.Equal(t, "Pauillac", roots[0].Children[0].Children[0].Class.Name)
+	assert.Empty(t, roots[1].Children)
+}
+
+func TestBuildClassTreeBreaksCycles(t *testing.T) {
+	classes := []Class{
+		{Id: "1", Name: "France", ParentRef: &ReferenceType{Value: "2"}},
+		{Id: "2", Name: "Bordeaux", ParentRef: &ReferenceType{Value: "1"}},
+		{Id: "3", Name: "Spain", ParentRef: &ReferenceType{Value: "3"}},
+	}
+
+	roots := BuildClassTree(classes)
+	require.Len(t, roots, 2)
+	assert.Equal(t, "Bordeaux", roots[0].Class.Name)
+	require.Len(t, roots[0].Children, 1)
+	assert.Equal(t, "France", roots[0].Children[0].Class.Name)
+	assert.Equal(t, "Spain", roots[1].Class.Name)
+	assert.Empty(t, roots[1].Children)
+}
@@ -30,7 +30,7 @@ type CompanyInfo struct {
 func (c *Client) FindCompanyInfo() (*CompanyInfo, error) {
 	var resp struct {
 		CompanyInfo CompanyInfo
-		Time        Date
+		Time        DateTime
 	}
 
 	if err := c.get("companyinfo/"+c.realmId, &resp, nil); err != nil {
@@ -41,31 +41,8 @@ func (c *Client) FindCompanyInfo() (*CompanyInfo, error) {
 }
 
 // UpdateCompanyInfo updates the company info
-func (c *Client) UpdateCompanyInfo(companyInfo *CompanyInfo) (*CompanyInfo, error) {
-	existingCompanyInfo, err := c.FindCompanyInfo()
-	if err != nil {
-		return nil, err
-	}
-
-	companyInfo.Id = existingCompanyInfo.Id
-	companyInfo.SyncToken = existingCompanyInfo.SyncToken
-
-	payload := struct {
-		*CompanyInfo
-		Sparse bool `json:"sparse"`
-	}{
-		CompanyInfo: companyInfo,
-		Sparse:      true,
-	}
-
-	var companyInfoData struct {
-		CompanyInfo CompanyInfo
-		Time        Date
-	}
-
-	if err = c.post("companyInfo", payload, &companyInfoData, nil); err != nil {
-		return nil, err
-	}
-
-	return &companyInfoData.CompanyInfo, err
+func (c *Client) UpdateCompanyInfo(companyInfo *CompanyInfo, opts ...UpdateOption) (*CompanyInfo, error) {
+	return update(c, "companyInfo", "CompanyInfo", companyInfo, func(string) (*CompanyInfo, error) {
+		return c.FindCompanyInfo()
+	}, opts)
 }
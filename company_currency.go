@@ -0,0 +1,114 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+// CompanyCurrency is a currency, besides the home currency, that the company
+// transacts in. Only available when multi-currency is enabled.
+type CompanyCurrency struct {
+	Id        string   `json:"Id,omitempty"`
+	SyncToken string   `json:",omitempty"`
+	MetaData  MetaData `json:",omitempty"`
+	Code      string   `json:",omitempty"`
+	Name      string   `json:",omitempty"`
+	Active    *bool    `json:",omitempty"`
+}
+
+// CreateCompanyCurrency creates the given CompanyCurrency on the QuickBooks server,
+// returning the resulting CompanyCurrency object.
+func (c *Client) CreateCompanyCurrency(companyCurrency *CompanyCurrency) (*CompanyCurrency, error) {
+	var resp struct {
+		CompanyCurrency CompanyCurrency
+		Time            DateTime
+	}
+
+	if err := c.post("companycurrency", companyCurrency, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.CompanyCurrency, nil
+}
+
+// FindCompanyCurrencies gets the full list of CompanyCurrencies in the QuickBooks account.
+func (c *Client) FindCompanyCurrencies() ([]CompanyCurrency, error) {
+	var resp struct {
+		QueryResponse struct {
+			CompanyCurrencies []CompanyCurrency `json:"CompanyCurrency"`
+			MaxResults        int
+			StartPosition     int
+			TotalCount        int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM CompanyCurrency", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no company currencies could be found")
+	}
+
+	companyCurrencies := make([]CompanyCurrency, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM CompanyCurrency ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.CompanyCurrencies == nil {
+			return nil, errors.New("no company currencies could be found")
+		}
+
+		companyCurrencies = append(companyCurrencies, resp.QueryResponse.CompanyCurrencies...)
+	}
+
+	return companyCurrencies, nil
+}
+
+// FindCompanyCurrencyById finds the company currency by the given id
+func (c *Client) FindCompanyCurrencyById(id string) (*CompanyCurrency, error) {
+	var resp struct {
+		CompanyCurrency CompanyCurrency
+		Time            DateTime
+	}
+
+	if err := c.get("companycurrency/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.CompanyCurrency, nil
+}
+
+// QueryCompanyCurrencies accepts an SQL query and returns all company currencies found using it
+func (c *Client) QueryCompanyCurrencies(query string) ([]CompanyCurrency, error) {
+	var resp struct {
+		QueryResponse struct {
+			CompanyCurrencies []CompanyCurrency `json:"CompanyCurrency"`
+			StartPosition     int
+			MaxResults        int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.CompanyCurrencies == nil {
+		return nil, errors.New("could not find any company currencies")
+	}
+
+	return resp.QueryResponse.CompanyCurrencies, nil
+}
+
+// UpdateCompanyCurrency updates the company currency
+func (c *Client) UpdateCompanyCurrency(companyCurrency *CompanyCurrency, opts ...UpdateOption) (*CompanyCurrency, error) {
+	if companyCurrency.Id == "" {
+		return nil, errors.New("missing company currency id")
+	}
+
+	return update(c, "companycurrency", "CompanyCurrency", companyCurrency, c.FindCompanyCurrencyById, opts)
+}
@@ -0,0 +1,175 @@
+package quickbooks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// CreditCardPaymentTxn represents a payment from a bank account towards the
+// balance of a credit card account.
+type CreditCardPaymentTxn struct {
+	Id                   string        `json:"Id,omitempty"`
+	SyncToken            string        `json:",omitempty"`
+	MetaData             MetaData      `json:",omitempty"`
+	TxnDate              *Date         `json:",omitempty"`
+	CreditCardAccountRef ReferenceType `json:",omitempty"`
+	BankAccountRef       ReferenceType `json:",omitempty"`
+	Amount               Decimal       `json:",omitempty"`
+	VendorRef            ReferenceType `json:",omitempty"`
+	CheckNum             string        `json:",omitempty"`
+	PrintStatus          string        `json:",omitempty"`
+	Memo                 *string       `json:",omitempty"`
+	PrivateNote          *string       `json:",omitempty"`
+}
+
+// CreateCreditCardPaymentTxn creates the given CreditCardPaymentTxn on the
+// QuickBooks server, returning the resulting CreditCardPaymentTxn object. The
+// referenced accounts are looked up first and must be a credit card account
+// and a bank account respectively.
+func (c *Client) CreateCreditCardPaymentTxn(creditCardPayment *CreditCardPaymentTxn) (*CreditCardPaymentTxn, error) {
+	if creditCardPayment.CreditCardAccountRef.Value == "" || creditCardPayment.BankAccountRef.Value == "" {
+		return nil, errors.New("missing credit card/bank account")
+	}
+
+	if err := c.validateCreditCardPaymentAccounts(creditCardPayment); err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		CreditCardPaymentTxn CreditCardPaymentTxn
+		Time                 DateTime
+	}
+
+	if err := c.post("creditcardpayment", creditCardPayment, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.CreditCardPaymentTxn, nil
+}
+
+// DeleteCreditCardPaymentTxn deletes the credit card payment
+func (c *Client) DeleteCreditCardPaymentTxn(creditCardPayment *CreditCardPaymentTxn) error {
+	if creditCardPayment.Id == "" || creditCardPayment.SyncToken == "" {
+		return errors.New("missing id/sync token")
+	}
+
+	return c.post("creditcardpayment", creditCardPayment, nil, map[string]string{"operation": "delete"})
+}
+
+// FindCreditCardPaymentTxns gets the full list of CreditCardPaymentTxns in the QuickBooks account.
+func (c *Client) FindCreditCardPaymentTxns() ([]CreditCardPaymentTxn, error) {
+	var resp struct {
+		QueryResponse struct {
+			CreditCardPayments []CreditCardPaymentTxn `json:"CreditCardPaymentTxn"`
+			MaxResults         int
+			StartPosition      int
+			TotalCount         int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM CreditCardPaymentTxn", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no credit card payments could be found")
+	}
+
+	creditCardPayments := make([]CreditCardPaymentTxn, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM CreditCardPaymentTxn ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.CreditCardPayments == nil {
+			return nil, errors.New("no credit card payments could be found")
+		}
+
+		creditCardPayments = append(creditCardPayments, resp.QueryResponse.CreditCardPayments...)
+	}
+
+	return creditCardPayments, nil
+}
+
+// FindCreditCardPaymentTxnById finds the credit card payment by the given id
+func (c *Client) FindCreditCardPaymentTxnById(id string) (*CreditCardPaymentTxn, error) {
+	var resp struct {
+		CreditCardPaymentTxn CreditCardPaymentTxn
+		Time                 DateTime
+	}
+
+	if err := c.get("creditcardpayment/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.CreditCardPaymentTxn, nil
+}
+
+// QueryCreditCardPaymentTxns accepts an SQL query and returns all credit card payments found using it
+func (c *Client) QueryCreditCardPaymentTxns(query string) ([]CreditCardPaymentTxn, error) {
+	var resp struct {
+		QueryResponse struct {
+			CreditCardPayments []CreditCardPaymentTxn `json:"CreditCardPaymentTxn"`
+			StartPosition      int
+			MaxResults         int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.CreditCardPayments == nil {
+		return nil, errors.New("could not find any credit card payments")
+	}
+
+	return resp.QueryResponse.CreditCardPayments, nil
+}
+
+// UpdateCreditCardPaymentTxn updates the credit card payment. Any account that
+// is being changed is validated as in CreateCreditCardPaymentTxn.
+func (c *Client) UpdateCreditCardPaymentTxn(creditCardPayment *CreditCardPaymentTxn, opts ...UpdateOption) (*CreditCardPaymentTxn, error) {
+	if creditCardPayment.Id == "" {
+		return nil, errors.New("missing credit card payment id")
+	}
+
+	if err := c.validateCreditCardPaymentAccounts(creditCardPayment); err != nil {
+		return nil, err
+	}
+
+	return update(c, "creditcardpayment", "CreditCardPaymentTxn", creditCardPayment, c.FindCreditCardPaymentTxnById, opts)
+}
+
+// validateCreditCardPaymentAccounts checks that the payment is from a bank
+// account to a credit card account. References left unset are skipped, as a
+// sparse update keeps the accounts the payment was created with.
+func (c *Client) validateCreditCardPaymentAccounts(creditCardPayment *CreditCardPaymentTxn) error {
+	checks := []struct {
+		ref         ReferenceType
+		accountType string
+	}{
+		{creditCardPayment.CreditCardAccountRef, CreditCardAccountType},
+		{creditCardPayment.BankAccountRef, BankAccountType},
+	}
+
+	for _, check := range checks {
+		if check.ref.Value == "" {
+			continue
+		}
+
+		account, err := c.FindAccountById(check.ref.Value)
+		if err != nil {
+			return fmt.Errorf("failed to find account %s: %v", check.ref.Value, err)
+		}
+
+		if account.AccountType != check.accountType {
+			return fmt.Errorf("account %s is a %s account, not a %s account", check.ref.Value, account.AccountType, check.accountType)
+		}
+	}
+
+	return nil
+}
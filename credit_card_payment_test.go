@@ -0,0 +1,57 @@
+package quickbooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCreditCardPaymentServer() *accountServer {
+	return &accountServer{
+		accounts: map[string]Account{
+			"35": {Id: "35", Name: "Checking", AccountType: BankAccountType},
+			"41": {Id: "41", Name: "Mastercard", AccountType: CreditCardAccountType},
+			"64": {Id: "64", Name: "Office Expenses", AccountType: ExpenseAccountType},
+		},
+		name: "CreditCardPaymentTxn",
+	}
+}
+
+func TestCreateCreditCardPaymentTxn(t *testing.T) {
+	server := newCreditCardPaymentServer()
+	client := newTestClient(t, server)
+
+	creditCardPayment, err := client.CreateCreditCardPaymentTxn(&CreditCardPaymentTxn{
+		CreditCardAccountRef: ReferenceType{Value: "41"},
+		BankAccountRef:       ReferenceType{Value: "35"},
+		Amount:               "600.00",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Decimal("600.00"), creditCardPayment.Amount)
+
+	require.Len(t, server.posts, 1)
+	assert.JSONEq(t, `{"value": "41"}`, string(server.posts[0]["CreditCardAccountRef"]))
+}
+
+func TestCreateCreditCardPaymentTxnRejectsAccounts(t *testing.T) {
+	server := newCreditCardPaymentServer()
+	client := newTestClient(t, server)
+
+	for _, creditCardPayment := range []CreditCardPaymentTxn{
+		// The accounts the wrong way round.
+		{CreditCardAccountRef: ReferenceType{Value: "35"}, BankAccountRef: ReferenceType{Value: "41"}},
+		{CreditCardAccountRef: ReferenceType{Value: "41"}, BankAccountRef: ReferenceType{Value: "64"}},
+		{CreditCardAccountRef: ReferenceType{Value: "41"}, BankAccountRef: ReferenceType{Value: "99"}},
+		{CreditCardAccountRef: ReferenceType{Value: "41"}},
+		{BankAccountRef: ReferenceType{Value: "35"}},
+	} {
+		_, err := client.CreateCreditCardPaymentTxn(&creditCardPayment)
+		assert.Error(t, err)
+	}
+
+	_, err := client.UpdateCreditCardPaymentTxn(&CreditCardPaymentTxn{Id: "12", BankAccountRef: ReferenceType{Value: "41"}})
+	assert.Error(t, err)
+
+	assert.Empty(t, server.posts)
+}
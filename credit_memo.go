@@ -1,22 +1,21 @@
 package quickbooks
 
 import (
-	"encoding/json"
 	"errors"
 	"strconv"
 )
 
 type CreditMemo struct {
-	TotalAmt              float64         `json:",omitempty"`
-	RemainingCredit       json.Number     `json:",omitempty"`
+	TotalAmt              Decimal         `json:",omitempty"`
+	RemainingCredit       Decimal         `json:",omitempty"`
 	Line                  []Line          `json:",omitempty"`
-	ApplyTaxAfterDiscount bool            `json:",omitempty"`
+	ApplyTaxAfterDiscount *bool           `json:",omitempty"`
 	DocNumber             string          `json:",omitempty"`
-	TxnDate               Date            `json:",omitempty"`
+	TxnDate               *Date           `json:",omitempty"`
 	Sparse                bool            `json:"sparse,omitempty"`
 	CustomerMemo          MemoRef         `json:",omitempty"`
 	ProjectRef            ReferenceType   `json:",omitempty"`
-	Balance               json.Number     `json:",omitempty"`
+	Balance               Decimal         `json:",omitempty"`
 	CustomerRef           ReferenceType   `json:",omitempty"`
 	TxnTaxDetail          *TxnTaxDetail   `json:",omitempty"`
 	SyncToken             string          `json:",omitempty"`
@@ -27,13 +26,14 @@ type CreditMemo struct {
 	MetaData              MetaData        `json:",omitempty"`
 	BillEmail             EmailAddress    `json:",omitempty"`
 	Id                    string          `json:",omitempty"`
+	RecurringInfo         *RecurringInfo  `json:",omitempty"`
 }
 
 // CreateCreditMemo creates the given CreditMemo witin QuickBooks.
 func (c *Client) CreateCreditMemo(creditMemo *CreditMemo) (*CreditMemo, error) {
 	var resp struct {
 		CreditMemo CreditMemo
-		Time       Date
+		Time       DateTime
 	}
 
 	if err := c.post("creditmemo", creditMemo, &resp, nil); err != nil {
@@ -94,7 +94,7 @@ func (c *Client) FindCreditMemos() ([]CreditMemo, error) {
 func (c *Client) FindCreditMemoById(id string) (*CreditMemo, error) {
 	var resp struct {
 		CreditMemo CreditMemo
-		Time       Date
+		Time       DateTime
 	}
 
 	if err := c.get("creditmemo/"+id, &resp, nil); err != nil {
@@ -126,34 +126,10 @@ func (c *Client) QueryCreditMemos(query string) ([]CreditMemo, error) {
 }
 
 // UpdateCreditMemo updates the given credit memo.
-func (c *Client) UpdateCreditMemo(creditMemo *CreditMemo) (*CreditMemo, error) {
+func (c *Client) UpdateCreditMemo(creditMemo *CreditMemo, opts ...UpdateOption) (*CreditMemo, error) {
 	if creditMemo.Id == "" {
 		return nil, errors.New("missing credit memo id")
 	}
 
-	existingCreditMemo, err := c.FindCreditMemoById(creditMemo.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	creditMemo.SyncToken = existingCreditMemo.SyncToken
-
-	payload := struct {
-		*CreditMemo
-		Sparse bool `json:"sparse"`
-	}{
-		CreditMemo: creditMemo,
-		Sparse:     true,
-	}
-
-	var creditMemoData struct {
-		CreditMemo CreditMemo
-		Time       Date
-	}
-
-	if err = c.post("creditmemo", payload, &creditMemoData, nil); err != nil {
-		return nil, err
-	}
-
-	return &creditMemoData.CreditMemo, err
+	return update(c, "creditmemo", "CreditMemo", creditMemo, c.FindCreditMemoById, opts)
 }
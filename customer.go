@@ -6,11 +6,16 @@ package quickbooks
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"strconv"
 	"strings"
+)
 
-	"gopkg.in/guregu/null.v4"
+// PreferredDeliveryMethod values say how a customer's invoices and statements
+// are sent by default.
+const (
+	PreferredDeliveryMethodPrint = "Print"
+	PreferredDeliveryMethodEmail = "Email"
+	PreferredDeliveryMethodNone  = "None"
 )
 
 // Customer represents a QuickBooks Customer object.
@@ -27,7 +32,7 @@ type Customer struct {
 	FullyQualifiedName string          `json:",omitempty"`
 	CompanyName        string          `json:",omitempty"`
 	PrintOnCheckName   string          `json:",omitempty"`
-	Active             bool            `json:",omitempty"`
+	Active             *bool           `json:",omitempty"`
 	PrimaryPhone       TelephoneNumber `json:",omitempty"`
 	AlternatePhone     TelephoneNumber `json:",omitempty"`
 	Mobile             TelephoneNumber `json:",omitempty"`
@@ -35,22 +40,69 @@ type Customer struct {
 	CustomerTypeRef    ReferenceType   `json:",omitempty"`
 	PrimaryEmailAddr   *EmailAddress   `json:",omitempty"`
 	WebAddr            *WebSiteAddress `json:",omitempty"`
-	// DefaultTaxCodeRef
-	Taxable              *bool            `json:",omitempty"`
+	DefaultTaxCodeRef  *ReferenceType  `json:",omitempty"`
+	Taxable            *bool           `json:",omitempty"`
+	// TaxExemptionReasonId is the id of one of QuickBooks' fixed exemption
+	// reasons, and is only used in the US.
 	TaxExemptionReasonId *string          `json:",omitempty"`
 	BillAddr             *PhysicalAddress `json:",omitempty"`
 	ShipAddr             *PhysicalAddress `json:",omitempty"`
-	Notes                string           `json:",omitempty"`
-	Job                  null.Bool        `json:",omitempty"`
-	BillWithParent       bool             `json:",omitempty"`
+	Notes                *string          `json:",omitempty"`
+	Job                  *bool            `json:",omitempty"`
+	BillWithParent       *bool            `json:",omitempty"`
 	ParentRef            ReferenceType    `json:",omitempty"`
 	Level                int              `json:",omitempty"`
-	// SalesTermRef
-	// PaymentMethodRef
-	Balance         json.Number `json:",omitempty"`
-	OpenBalanceDate Date        `json:",omitempty"`
-	BalanceWithJobs json.Number `json:",omitempty"`
-	// CurrencyRef
+	// IsProject is set by QuickBooks on sub-customers that are projects. It
+	// is read only.
+	IsProject               *bool          `json:",omitempty"`
+	SalesTermRef            *ReferenceType `json:",omitempty"`
+	PaymentMethodRef        *ReferenceType `json:",omitempty"`
+	PreferredDeliveryMethod string         `json:",omitempty"`
+	ARAccountRef            *ReferenceType `json:",omitempty"`
+	Balance                 Decimal        `json:",omitempty"`
+	OpenBalanceDate         *Date          `json:",omitempty"`
+	BalanceWithJobs         Decimal        `json:",omitempty"`
+	CurrencyRef             *ReferenceType `json:",omitempty"`
+	ResaleNum               string         `json:",omitempty"`
+	// PrimaryTaxIdentifier and SecondaryTaxIdentifier are masked when read
+	// back, as XXXXXX1234. Masked values are left out of requests, so that
+	// writing back a fetched customer doesn't overwrite them. A full update,
+	// which would clear them, is refused while either is masked.
+	PrimaryTaxIdentifier   string        `json:",omitempty"`
+	SecondaryTaxIdentifier string        `json:",omitempty"`
+	BusinessNumber         string        `json:",omitempty"`
+	GSTIN                  string        `json:",omitempty"`
+	GSTRegistrationType    string        `json:",omitempty"`
+	TaxRegimeId            string        `json:",omitempty"`
+	Source                 string        `json:",omitempty"`
+	CustomField            []CustomField `json:",omitempty"`
+}
+
+// MarshalJSON writes the customer, leaving out tax identifiers that are still
+// masked as QuickBooks returned them.
+func (c Customer) MarshalJSON() ([]byte, error) {
+	type customer Customer
+
+	out := customer(c)
+	if maskedTaxIdentifier(out.PrimaryTaxIdentifier) {
+		out.PrimaryTaxIdentifier = ""
+	}
+	if maskedTaxIdentifier(out.SecondaryTaxIdentifier) {
+		out.SecondaryTaxIdentifier = ""
+	}
+
+	return json.Marshal(out)
+}
+
+// maskedTaxIdentifier reports whether id is a tax identifier as QuickBooks
+// returns it: a run of Xs followed by the last few digits.
+func maskedTaxIdentifier(id string) bool {
+	digits := strings.TrimLeft(id, "X")
+	if len(id)-len(digits) < 3 || len(digits) == 0 || len(digits) > 4 {
+		return false
+	}
+
+	return strings.Trim(digits, "0123456789") == ""
 }
 
 // GetAddress prioritizes the ship address, but falls back on bill address
@@ -85,7 +137,7 @@ func (c *Customer) GetPrimaryEmail() string {
 func (c *Client) CreateCustomer(customer *Customer) (*Customer, error) {
 	var resp struct {
 		Customer Customer
-		Time     Date
+		Time     DateTime
 	}
 
 	if err := c.post("customer", customer, &resp, nil); err != nil {
@@ -137,7 +189,7 @@ func (c *Client) FindCustomers() ([]Customer, error) {
 func (c *Client) FindCustomerById(id string) (*Customer, error) {
 	var r struct {
 		Customer Customer
-		Time     Date
+		Time     DateTime
 	}
 
 	if err := c.get("customer/"+id, &r, nil); err != nil {
@@ -193,34 +245,14 @@ func (c *Client) QueryCustomers(query string) ([]Customer, error) {
 // UpdateCustomer updates the given Customer on the QuickBooks server,
 // returning the resulting Customer object. It's a sparse update, as not all QB
 // fields are present in our Customer object.
-func (c *Client) UpdateCustomer(customer *Customer) (*Customer, error) {
+func (c *Client) UpdateCustomer(customer *Customer, opts ...UpdateOption) (*Customer, error) {
 	if customer.Id == "" {
 		return nil, errors.New("missing customer id")
 	}
 
-	existingCustomer, err := c.FindCustomerById(customer.Id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find existing customer: %v", err)
-	}
-
-	customer.SyncToken = existingCustomer.SyncToken
-
-	payload := struct {
-		*Customer
-		Sparse bool `json:"sparse"`
-	}{
-		Customer: customer,
-		Sparse:   true,
-	}
-
-	var customerData struct {
-		Customer Customer
-		Time     Date
-	}
-
-	if err = c.post("customer", payload, &customerData, nil); err != nil {
-		return nil, err
+	if newUpdateOptions(opts).full && (maskedTaxIdentifier(customer.PrimaryTaxIdentifier) || maskedTaxIdentifier(customer.SecondaryTaxIdentifier)) {
+		return nil, errors.New("a full update would clear the masked tax identifiers; set them or use a sparse update")
 	}
 
-	return &customerData.Customer, nil
+	return update(c, "customer", "Customer", customer, c.FindCustomerById, opts)
 }
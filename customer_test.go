@@ -0,0 +1,180 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomer(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/customer.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Customer Customer
+		Time     DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	customer := r.Customer
+	assert.Equal(t, "58", customer.Id)
+	assert.Equal(t, "Amy's Bird Sanctuary:Aviary", customer.DisplayName)
+	require.NotNil(t, customer.DefaultTaxCodeRef)
+	assert.Equal(t, "2", customer.DefaultTaxCodeRef.Value)
+	require.NotNil(t, customer.SalesTermRef)
+	assert.Equal(t, "3", customer.SalesTermRef.Value)
+	require.NotNil(t, customer.PaymentMethodRef)
+	assert.Equal(t, "2", customer.PaymentMethodRef.Value)
+	require.NotNil(t, customer.ARAccountRef)
+	assert.Equal(t, "84", customer.ARAccountRef.Value)
+	require.NotNil(t, customer.CurrencyRef)
+	assert.Equal(t, "USD", customer.CurrencyRef.Value)
+	assert.Equal(t, PreferredDeliveryMethodPrint, customer.PreferredDeliveryMethod)
+	assert.Equal(t, "RS-4581", customer.ResaleNum)
+	assert.Equal(t, "XXXXXX1234", customer.PrimaryTaxIdentifier)
+	assert.Equal(t, "XXXXXX5678", customer.SecondaryTaxIdentifier)
+	assert.Equal(t, "123456789", customer.BusinessNumber)
+	assert.Equal(t, "29ABCDE1234F2Z5", customer.GSTIN)
+	assert.Equal(t, "GST_REG_REG", customer.GSTRegistrationType)
+	assert.Equal(t, "01", customer.TaxRegimeId)
+	assert.Equal(t, "QBCommerce", customer.Source)
+	assert.Equal(t, Bool(true), customer.IsProject)
+	assert.Equal(t, Bool(true), customer.Job)
+	assert.Equal(t, "1", customer.ParentRef.Value)
+	assert.Equal(t, 1, customer.Level)
+	assert.Equal(t, Decimal("239.00"), customer.BalanceWithJobs)
+	require.Len(t, customer.CustomField, 1)
+	assert.Equal(t, "Region", customer.CustomField[0].Name)
+	assert.Equal(t, "West", customer.CustomField[0].StringValue)
+
+	// Everything read must be written back unchanged, so that a
+	// read-modify-write doesn't drop any of it, except for the masked tax
+	// identifiers.
+	var fixture struct {
+		Customer map[string]json.RawMessage
+	}
+	require.NoError(t, json.Unmarshal(byteValue, &fixture))
+
+	b, err := json.Marshal(customer)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+
+	for key, value := range fixture.Customer {
+		if key == "domain" || key == "sparse" || key == "PrimaryTaxIdentifier" || key == "SecondaryTaxIdentifier" {
+			continue
+		}
+
+		require.Contains(t, fields, key)
+		assert.JSONEq(t, string(value), string(fields[key]), key)
+	}
+
+	assert.NotContains(t, fields, "PrimaryTaxIdentifier")
+	assert.NotContains(t, fields, "SecondaryTaxIdentifier")
+}
+
+func TestUpdateCustomerLeavesOutMaskedTaxIdentifiers(t *testing.T) {
+	fixture, err := ioutil.ReadFile("data/testing/customer.json")
+	require.NoError(t, err)
+
+	var posts []map[string]json.RawMessage
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			var fields map[string]json.RawMessage
+			json.Unmarshal(body, &fields)
+			posts = append(posts, fields)
+		}
+		w.Write(fixture)
+	}))
+
+	customer, err := client.FindCustomerById("58")
+	require.NoError(t, err)
+	assert.Equal(t, "XXXXXX1234", customer.PrimaryTaxIdentifier)
+
+	customer.Notes = String("Prefers email")
+
+	_, err = client.UpdateCustomer(customer)
+	require.NoError(t, err)
+
+	require.Len(t, posts, 1)
+	assert.JSONEq(t, `"Prefers email"`, string(posts[0]["Notes"]))
+	assert.NotContains(t, posts[0], "PrimaryTaxIdentifier")
+	assert.NotContains(t, posts[0], "SecondaryTaxIdentifier")
+
+	// A full update would clear the masked identifiers, so it is refused.
+	_, err = client.UpdateCustomer(customer, FullUpdate())
+	assert.Error(t, err)
+	assert.Len(t, posts, 1)
+
+	customer.PrimaryTaxIdentifier = "123456789"
+
+	_, err = client.UpdateCustomer(customer)
+	require.NoError(t, err)
+
+	require.Len(t, posts, 2)
+	assert.JSONEq(t, `"123456789"`, string(posts[1]["PrimaryTaxIdentifier"]))
+	assert.NotContains(t, posts[1], "SecondaryTaxIdentifier")
+
+	customer.SecondaryTaxIdentifier = ""
+
+	_, err = client.UpdateCustomer(customer, FullUpdate())
+	require.NoError(t, err)
+
+	require.Len(t, posts, 3)
+	assert.JSONEq(t, `"123456789"`, string(posts[2]["PrimaryTaxIdentifier"]))
+	assert.NotContains(t, posts[2], "SecondaryTaxIdentifier")
+}
+
+func TestMaskedTaxIdentifier(t *testing.T) {
+	for _, id := range []string{"XXXXXX1234", "XXXXX678", "XXX1"} {
+		assert.True(t, maskedTaxIdentifier(id), id)
+	}
+
+	for _, id := range []string{"", "X1234", "XX1234", "XJ123456", "X12345678", "XXXXXX", "XXXXXX12345", "XXXXAB12", "123456789"} {
+		assert.False(t, maskedTaxIdentifier(id), id)
+	}
+
+	b, err := json.Marshal(Customer{PrimaryTaxIdentifier: "X12345678", SecondaryTaxIdentifier: "XXXXXX5678"})
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.JSONEq(t, `"X12345678"`, string(fields["PrimaryTaxIdentifier"]))
+	assert.NotContains(t, fields, "SecondaryTaxIdentifier")
+}
+
+func TestCustomerSparseZeroValues(t *testing.T) {
+	customer := Customer{
+		Id:     "58",
+		Active: Bool(false),
+		Notes:  String(""),
+	}
+
+	b, err := json.Marshal(customer)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+
+	assert.JSONEq(t, `false`, string(fields["Active"]))
+	assert.JSONEq(t, `""`, string(fields["Notes"]))
+
+	// Fields that aren't set are left out rather than sent as null.
+	for _, key := range []string{"Taxable", "Job", "BillWithParent", "IsProject", "OpenBalanceDate"} {
+		assert.NotContains(t, fields, key)
+	}
+}
@@ -0,0 +1,157 @@
+package quickbooks
+
+import (
+	"errors"
+)
+
+// CustomerNode is a customer together with its place in the hierarchy of
+// customers and sub-customers (jobs).
+type CustomerNode struct {
+	Customer Customer
+	// Parent is nil for a top-level customer.
+	Parent   *CustomerNode
+	Children []*CustomerNode
+}
+
+// CustomerTree is a list of customers arranged into a hierarchy following
+// their ParentRef.
+type CustomerTree struct {
+	// Roots are the top-level customers, in the order given.
+	Roots []*CustomerNode
+	nodes map[string]*CustomerNode
+}
+
+// BuildCustomerTree arranges the given customers into a tree following their
+// ParentRef. A customer whose parent isn't in the list, or whose parent would
+// make a cycle, is treated as top-level. Children are kept in the order
+// given.
+func BuildCustomerTree(customers []Customer) *CustomerTree {
+	tree := &CustomerTree{nodes: make(map[string]*CustomerNode, len(customers))}
+
+	nodes := make([]*CustomerNode, len(customers))
+	ids := make([]string, len(customers))
+	parentIds := make([]string, len(customers))
+
+	for i := range customers {
+		nodes[i] = &CustomerNode{Customer: customers[i]}
+		tree.nodes[customers[i].Id] = nodes[i]
+		ids[i] = customers[i].Id
+		parentIds[i] = customers[i].ParentRef.Value
+	}
+
+	for i, parent := range treeParents(ids, parentIds) {
+		if parent < 0 {
+			tree.Roots = append(tree.Roots, nodes[i])
+			continue
+		}
+
+		nodes[i].Parent = nodes[parent]
+		nodes[parent].Children = append(nodes[parent].Children, nodes[i])
+	}
+
+	return tree
+}
+
+// Node returns the node for the customer with the given id, or nil if it isn't
+// in the tree.
+func (t *CustomerTree) Node(id string) *CustomerNode {
+	return t.nodes[id]
+}
+
+// BalanceMismatches returns the customers whose BalanceWithJobs differs from
+// their own Balance plus that of all their sub-customers in the tree, in
+// depth-first order. Build the tree from every customer, inactive ones
+// included, or sub-customers that are left out will show up here.
+func (t *CustomerTree) BalanceMismatches() []*CustomerNode {
+	var mismatches []*CustomerNode
+
+	for _, root := range t.Roots {
+		for _, node := range append([]*CustomerNode{root}, root.Descendants()...) {
+			if node.RolledUpBalance().Cmp(node.Customer.BalanceWithJobs) != 0 {
+				mismatches = append(mismatches, node)
+			}
+		}
+	}
+
+	return mismatches
+}
+
+// Ancestors returns the customer's parent, grandparent and so on, ending
+// with its top-level customer.
+func (n *CustomerNode) Ancestors() []*CustomerNode {
+	var ancestors []*CustomerNode
+	for node := n.Parent; node != nil; node = node.Parent {
+		ancestors = append(ancestors, node)
+	}
+
+	return ancestors
+}
+
+// Descendants returns all of the customer's sub-customers at every level, in
+// depth-first order.
+func (n *CustomerNode) Descendants() []*CustomerNode {
+	var descendants []*CustomerNode
+	for _, child := range n.Children {
+		descendants = append(descendants, child)
+		descendants = append(descendants, child.Descendants()...)
+	}
+
+	return descendants
+}
+
+// RolledUpBalance returns the customer's own Balance plus that of all its
+// sub-customers, which QuickBooks reports as BalanceWithJobs.
+func (n *CustomerNode) RolledUpBalance() Decimal {
+	balance := n.Customer.Balance
+	for _, child := range n.Children {
+		balance = balance.Add(child.RolledUpBalance())
+	}
+
+	return balance
+}
+
+// CreateSubCustomer creates the given Customer on the QuickBooks server as a
+// sub-customer (job) of the parent customer, returning the resulting Customer
+// object.
+func (c *Client) CreateSubCustomer(parent *Customer, customer *Customer) (*Customer, error) {
+	if parent.Id == "" {
+		return nil, errors.New("missing parent customer id")
+	}
+
+	subCustomer := *customer
+	subCustomer.Job = Bool(true)
+	subCustomer.ParentRef = ReferenceType{Value: parent.Id}
+
+	return c.CreateCustomer(&subCustomer)
+}
+
+// ReparentCustomer moves the customer under the customer with the given
+// parent id, or makes it a top-level customer if parentId is empty. Only the
+// customer's place in the hierarchy is changed, with a sparse update, so the
+// only option it takes is KeepSyncToken; FullUpdate is refused.
+func (c *Client) ReparentCustomer(customer *Customer, parentId string, opts ...UpdateOption) (*Customer, error) {
+	if customer.Id == "" {
+		return nil, errors.New("missing customer id")
+	}
+
+	if newUpdateOptions(opts).full {
+		return nil, errors.New("reparenting a customer is always a sparse update")
+	}
+
+	if parentId == customer.Id {
+		return nil, errors.New("a customer can't be its own parent")
+	}
+
+	reparented := &Customer{
+		Id:        customer.Id,
+		SyncToken: customer.SyncToken,
+		Job:       Bool(parentId != ""),
+		ParentRef: ReferenceType{Value: parentId},
+	}
+
+	if parentId == "" {
+		reparented.BillWithParent = Bool(false)
+	}
+
+	return update(c, "customer", "Customer", reparented, c.FindCustomerById, opts)
+}
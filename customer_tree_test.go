@@ -0,0 +1,136 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCustomers() []Customer {
+	return []Customer{
+		{Id: "3", DisplayName: "Aviary", ParentRef: ReferenceType{Value: "1"}, Balance: "100.00", BalanceWithJobs: "150.00"},
+		{Id: "1", DisplayName: "Amy's Bird Sanctuary", Balance: "39.00", BalanceWithJobs: "189.00"},
+		{Id: "4", DisplayName: "Perches", ParentRef: ReferenceType{Value: "3"}, Balance: "50.00", BalanceWithJobs: "50.00"},
+		{Id: "2", DisplayName: "Bill's Windsurf Shop", Balance: "85.00", BalanceWithJobs: "85.00"},
+		{Id: "5", DisplayName: "Orphan", ParentRef: ReferenceType{Value: "99"}, Balance: "10", BalanceWithJobs: "12"},
+	}
+}
+
+func TestBuildCustomerTree(t *testing.T) {
+	tree := BuildCustomerTree(testCustomers())
+
+	require.Len(t, tree.Roots, 3)
+	assert.Equal(t, "1", tree.Roots[0].Customer.Id)
+	assert.Equal(t, "2", tree.Roots[1].Customer.Id)
+	assert.Equal(t, "5", tree.Roots[2].Customer.Id)
+
+	perches := tree.Node("4")
+	require.NotNil(t, perches)
+	require.NotNil(t, perches.Parent)
+	assert.Equal(t, "3", perches.Parent.Customer.Id)
+
+	ancestors := perches.Ancestors()
+	require.Len(t, ancestors, 2)
+	assert.Equal(t, "3", ancestors[0].Customer.Id)
+	assert.Equal(t, "1", ancestors[1].Customer.Id)
+
+	descendants := tree.Node("1").Descendants()
+	require.Len(t, descendants, 2)
+	assert.Equal(t, "3", descendants[0].Customer.Id)
+	assert.Equal(t, "4", descendants[1].Customer.Id)
+
+	assert.Nil(t, tree.Roots[0].Parent)
+	assert.Empty(t, tree.Roots[1].Children)
+	assert.Nil(t, tree.Node("99"))
+}
+
+func TestBuildCustomerTreeBreaksCycles(t *testing.T) {
+	tree := BuildCustomerTree([]Customer{
+		{Id: "1", ParentRef: ReferenceType{Value: "2"}},
+		{Id: "2", ParentRef: ReferenceType{Value: "1"}},
+		{Id: "3", ParentRef: ReferenceType{Value: "3"}},
+	})
+
+	require.Len(t, tree.Roots, 2)
+	assert.Equal(t, "2", tree.Roots[0].Customer.Id)
+	assert.Equal(t, "3", tree.Roots[1].Customer.Id)
+	assert.Len(t, tree.Roots[0].Descendants(), 1)
+	assert.Empty(t, tree.Roots[1].Children)
+}
+
+func TestCustomerTreeBalances(t *testing.T) {
+	tree := BuildCustomerTree(testCustomers())
+
+	assert.Equal(t, Decimal("189.00"), tree.Node("1").RolledUpBalance())
+	assert.Equal(t, Decimal("150.00"), tree.Node("3").RolledUpBalance())
+
+	mismatches := tree.BalanceMismatches()
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, "5", mismatches[0].Customer.Id)
+}
+
+func TestCreateSubCustomer(t *testing.T) {
+	var posted map[string]json.RawMessage
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &posted)
+		w.Write([]byte(`{"Customer": {"Id": "6", "Job": true, "ParentRef": {"value": "1"}, "Level": 1}}`))
+	}))
+
+	customer := &Customer{DisplayName: "Nest boxes"}
+
+	created, err := client.CreateSubCustomer(&Customer{Id: "1"}, customer)
+	require.NoError(t, err)
+	assert.Equal(t, "6", created.Id)
+	assert.Equal(t, 1, created.Level)
+
+	assert.JSONEq(t, `true`, string(posted["Job"]))
+	assert.JSONEq(t, `{"value": "1"}`, string(posted["ParentRef"]))
+	assert.JSONEq(t, `"Nest boxes"`, string(posted["DisplayName"]))
+	assert.Equal(t, ReferenceType{}, customer.ParentRef)
+
+	_, err = client.CreateSubCustomer(&Customer{}, customer)
+	assert.Error(t, err)
+}
+
+func TestReparentCustomer(t *testing.T) {
+	server := &customerServer{customer: Customer{Id: "58", SyncToken: "3", DisplayName: "Aviary"}}
+	client := newTestClient(t, server)
+
+	_, err := client.ReparentCustomer(&Customer{Id: "58"}, "1")
+	require.NoError(t, err)
+
+	require.Len(t, server.posts, 1)
+	assert.JSONEq(t, `true`, string(server.posts[0]["sparse"]))
+	assert.JSONEq(t, `"3"`, string(server.posts[0]["SyncToken"]))
+	assert.JSONEq(t, `true`, string(server.posts[0]["Job"]))
+	assert.JSONEq(t, `{"value": "1"}`, string(server.posts[0]["ParentRef"]))
+	assert.NotContains(t, server.posts[0], "DisplayName")
+
+	_, err = client.ReparentCustomer(&Customer{Id: "58"}, "")
+	require.NoError(t, err)
+
+	require.Len(t, server.posts, 2)
+	assert.JSONEq(t, `false`, string(server.posts[1]["Job"]))
+	assert.JSONEq(t, `false`, string(server.posts[1]["BillWithParent"]))
+
+	_, err = client.ReparentCustomer(&Customer{Id: "58"}, "58")
+	assert.Error(t, err)
+	assert.Len(t, server.posts, 2)
+
+	_, err = client.ReparentCustomer(&Customer{Id: "58"}, "1", FullUpdate())
+	assert.Error(t, err)
+	assert.Len(t, server.posts, 2)
+
+	_, err = client.ReparentCustomer(&Customer{Id: "58", SyncToken: "5"}, "1", KeepSyncToken())
+	require.NoError(t, err)
+
+	require.Len(t, server.posts, 3)
+	assert.JSONEq(t, `"5"`, string(server.posts[2]["SyncToken"]))
+	assert.JSONEq(t, `true`, string(server.posts[2]["sparse"]))
+}
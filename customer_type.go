@@ -2,22 +2,77 @@ package quickbooks
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 )
 
 type CustomerType struct {
 	SyncToken string   `json:",omitempty"`
 	Domain    string   `json:"domain,omitempty"`
 	Name      string   `json:",omitempty"`
-	Active    bool     `json:",omitempty"`
+	Active    *bool    `json:",omitempty"`
 	Id        string   `json:",omitempty"`
 	MetaData  MetaData `json:",omitempty"`
 }
 
+// CreateCustomerType creates the given CustomerType on the QuickBooks server,
+// returning the resulting CustomerType object.
+func (c *Client) CreateCustomerType(customerType *CustomerType) (*CustomerType, error) {
+	var resp struct {
+		CustomerType CustomerType
+		Time         DateTime
+	}
+
+	if err := c.post("customertype", customerType, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.CustomerType, nil
+}
+
+// FindCustomerTypes gets the full list of CustomerTypes in the QuickBooks account.
+func (c *Client) FindCustomerTypes() ([]CustomerType, error) {
+	var resp struct {
+		QueryResponse struct {
+			CustomerTypes []CustomerType `json:"CustomerType"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM CustomerType", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no customer types could be found")
+	}
+
+	customerTypes := make([]CustomerType, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM CustomerType ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.CustomerTypes == nil {
+			return nil, errors.New("no customer types could be found")
+		}
+
+		customerTypes = append(customerTypes, resp.QueryResponse.CustomerTypes...)
+	}
+
+	return customerTypes, nil
+}
+
 // FindCustomerTypeById returns a customerType with a given Id.
 func (c *Client) FindCustomerTypeById(id string) (*CustomerType, error) {
 	var r struct {
 		CustomerType CustomerType
-		Time         Date
+		Time         DateTime
 	}
 
 	if err := c.get("customertype/"+id, &r, nil); err != nil {
@@ -47,3 +102,60 @@ func (c *Client) QueryCustomerTypes(query string) ([]CustomerType, error) {
 
 	return resp.QueryResponse.CustomerTypes, nil
 }
+
+// UpdateCustomerType updates the customer type
+func (c *Client) UpdateCustomerType(customerType *CustomerType, opts ...UpdateOption) (*CustomerType, error) {
+	if customerType.Id == "" {
+		return nil, errors.New("missing customer type id")
+	}
+
+	return update(c, "customertype", "CustomerType", customerType, c.FindCustomerTypeById, opts)
+}
+
+// ResolveCustomerTypeRef returns the reference to put in a Customer's
+// CustomerTypeRef for the customer type with the given name. If there is no
+// such type and create is true, it is created; otherwise an error is returned.
+func (c *Client) ResolveCustomerTypeRef(name string, create bool) (ReferenceType, error) {
+	if name == "" {
+		return ReferenceType{}, errors.New("missing customer type name")
+	}
+
+	var resp struct {
+		QueryResponse struct {
+			CustomerTypes []CustomerType `json:"CustomerType"`
+		}
+	}
+
+	query := "SELECT * FROM CustomerType WHERE Name = '" + strings.Replace(name, "'", "''", -1) + "'"
+
+	if err := c.query(query, &resp); err != nil {
+		return ReferenceType{}, err
+	}
+
+	if len(resp.QueryResponse.CustomerTypes) > 0 {
+		return ReferenceType{Value: resp.QueryResponse.CustomerTypes[0].Id, Name: resp.QueryResponse.CustomerTypes[0].Name}, nil
+	}
+
+	if !create {
+		return ReferenceType{}, errors.New("no customer type named " + name)
+	}
+
+	customerType, err := c.CreateCustomerType(&CustomerType{Name: name})
+	if err != nil {
+		return ReferenceType{}, err
+	}
+
+	return ReferenceType{Value: customerType.Id, Name: customerType.Name}, nil
+}
+
+// CustomerTypeRefs returns a reference for each of the given customer types,
+// keyed by name, for resolving CustomerTypeRef on many customers at once.
+func CustomerTypeRefs(customerTypes []CustomerType) map[string]ReferenceType {
+	refs := make(map[string]ReferenceType, len(customerTypes))
+
+	for _, customerType := range customerTypes {
+		refs[customerType.Name] = ReferenceType{Value: customerType.Id, Name: customerType.Name}
+	}
+
+	return refs
+}
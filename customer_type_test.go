@@ -0,0 +1,74 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerTypeRefs(t *testing.T) {
+	refs := CustomerTypeRefs([]CustomerType{
+		{Id: "5000000000000117683", Name: "Retail"},
+		{Id: "5000000000000117684", Name: "Wholesale"},
+	})
+
+	assert.Len(t, refs, 2)
+	assert.Equal(t, ReferenceType{Value: "5000000000000117684", Name: "Wholesale"}, refs["Wholesale"])
+	_, ok := refs["Online"]
+	assert.False(t, ok)
+}
+
+func TestResolveCustomerTypeRef(t *testing.T) {
+	var queries []string
+	var posts []map[string]json.RawMessage
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/query":
+			query := r.URL.Query().Get("query")
+			queries = append(queries, query)
+
+			if query == "SELECT * FROM CustomerType WHERE Name = 'Retail'" {
+				w.Write([]byte(`{"QueryResponse": {"CustomerType": [{"Id": "5000000000000117683", "Name": "Retail"}]}}`))
+				return
+			}
+			w.Write([]byte(`{"QueryResponse": {}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/customertype":
+			body, _ := ioutil.ReadAll(r.Body)
+
+			var fields map[string]json.RawMessage
+			json.Unmarshal(body, &fields)
+			posts = append(posts, fields)
+
+			w.Write([]byte(`{"CustomerType": {"Id": "5000000000000117685", "Name": "Trade's Own"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	ref, err := client.ResolveCustomerTypeRef("Retail", true)
+	require.NoError(t, err)
+	assert.Equal(t, ReferenceType{Value: "5000000000000117683", Name: "Retail"}, ref)
+	assert.Empty(t, posts)
+
+	_, err = client.ResolveCustomerTypeRef("Trade's Own", false)
+	assert.Error(t, err)
+	assert.Empty(t, posts)
+
+	ref, err = client.ResolveCustomerTypeRef("Trade's Own", true)
+	require.NoError(t, err)
+	assert.Equal(t, ReferenceType{Value: "5000000000000117685", Name: "Trade's Own"}, ref)
+	require.Len(t, posts, 1)
+	assert.JSONEq(t, `"Trade's Own"`, string(posts[0]["Name"]))
+
+	assert.Equal(t, "SELECT * FROM CustomerType WHERE Name = 'Trade''s Own'", queries[1])
+	assert.Len(t, queries, 3)
+
+	_, err = client.ResolveCustomerTypeRef("", true)
+	assert.Error(t, err)
+	assert.Len(t, queries, 3)
+}
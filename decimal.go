@@ -0,0 +1,318 @@
+package quickbooks
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, used for every amount, quantity, rate
+// and balance. It holds the number's decimal text, so values read from
+// QuickBooks keep the precision they were sent with, and the zero value ""
+// is left out of requests by omitempty while "0" is sent.
+//
+// Arithmetic is exact apart from Div, which rounds. The methods panic if a
+// value isn't a valid decimal; values built with NewDecimal or decoded from
+// JSON always are.
+type Decimal string
+
+// NewDecimal parses s as a decimal number, such as "-12.50" or "1.5e3".
+func NewDecimal(s string) (Decimal, error) {
+	if s == "" {
+		return "", errors.New("invalid decimal: empty string")
+	}
+
+	unscaled, scale, err := Decimal(s).parse()
+	if err != nil {
+		return "", err
+	}
+
+	return formatDecimal(unscaled, scale), nil
+}
+
+// NewDecimalFromInt returns the decimal with the value i.
+func NewDecimalFromInt(i int64) Decimal {
+	return Decimal(strconv.FormatInt(i, 10))
+}
+
+// NewDecimalFromFloat returns the shortest decimal that converts back to f.
+// Prefer NewDecimal for values that are known exactly.
+func NewDecimalFromFloat(f float64) Decimal {
+	return Decimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// maxDecimalExponent bounds the exponent of a decimal such as "1.5e3", so
+// that a value like "1e999999999" can't make parse build an enormous number.
+const maxDecimalExponent = 1000
+
+// parse splits d into an unscaled integer and the number of digits after the
+// decimal point, so that d is unscaled / 10^scale. The empty decimal is zero.
+func (d Decimal) parse() (*big.Int, int, error) {
+	s := string(d)
+	if s == "" {
+		return new(big.Int), 0, nil
+	}
+
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return nil, 0, errors.New("invalid decimal: " + string(d))
+		}
+		exponent = e
+		s = s[:i]
+	}
+
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+
+	digits := whole + fraction
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, 0, errors.New("invalid decimal: " + string(d))
+	}
+
+	unscaled, _ := new(big.Int).SetString(digits, 10)
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+
+	scale := len(fraction) - exponent
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+
+	return unscaled, scale, nil
+}
+
+// mustParse is parse for the arithmetic methods, which panic on bad input.
+func (d Decimal) mustParse() (*big.Int, int) {
+	unscaled, scale, err := d.parse()
+	if err != nil {
+		panic("quickbooks: " + err.Error())
+	}
+
+	return unscaled, scale
+}
+
+// formatDecimal returns unscaled / 10^scale with exactly scale digits after
+// the decimal point.
+func formatDecimal(unscaled *big.Int, scale int) Decimal {
+	digits := new(big.Int).Abs(unscaled).String()
+
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+
+	if unscaled.Sign() < 0 {
+		digits = "-" + digits
+	}
+
+	return Decimal(digits)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// rescale returns unscaled, currently at scale, multiplied up to the larger
+// scale to.
+func rescale(unscaled *big.Int, scale int, to int) *big.Int {
+	if to == scale {
+		return unscaled
+	}
+
+	return new(big.Int).Mul(unscaled, pow10(to-scale))
+}
+
+// align parses d and e and brings them to the same scale.
+func align(d Decimal, e Decimal) (*big.Int, *big.Int, int) {
+	a, as := d.mustParse()
+	b, bs := e.mustParse()
+
+	scale := as
+	if bs > scale {
+		scale = bs
+	}
+
+	return rescale(a, as, scale), rescale(b, bs, scale), scale
+}
+
+// quoRound returns num / den rounded to an integer, with halves rounded away
+// from zero.
+func quoRound(num *big.Int, den *big.Int) *big.Int {
+	if den.Sign() < 0 {
+		num, den = new(big.Int).Neg(num), new(big.Int).Neg(den)
+	}
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(den) >= 0 {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+
+	return quo
+}
+
+// Add returns d + e.
+func (d Decimal) Add(e Decimal) Decimal {
+	a, b, scale := align(d, e)
+	return formatDecimal(new(big.Int).Add(a, b), scale)
+}
+
+// Sub returns d - e.
+func (d Decimal) Sub(e Decimal) Decimal {
+	a, b, scale := align(d, e)
+	return formatDecimal(new(big.Int).Sub(a, b), scale)
+}
+
+// Mul returns d * e.
+func (d Decimal) Mul(e Decimal) Decimal {
+	a, as := d.mustParse()
+	b, bs := e.mustParse()
+
+	return formatDecimal(new(big.Int).Mul(a, b), as+bs)
+}
+
+// Div returns d / e rounded to the given number of decimal places, with
+// halves rounded away from zero. It panics if e is zero.
+func (d Decimal) Div(e Decimal, places int) Decimal {
+	a, as := d.mustParse()
+	b, bs := e.mustParse()
+
+	if b.Sign() == 0 {
+		panic("quickbooks: division by zero")
+	}
+
+	// d / e = (a / 10^as) / (b / 10^bs), scaled up by 10^places.
+	num := new(big.Int).Mul(a, pow10(bs+places))
+	den := new(big.Int).Mul(b, pow10(as))
+
+	return formatDecimal(quoRound(num, den), places)
+}
+
+// Round returns d rounded to the given number of decimal places, with halves
+// rounded away from zero as QuickBooks does. The result always has exactly
+// that many places.
+func (d Decimal) Round(places int) Decimal {
+	unscaled, scale := d.mustParse()
+
+	if places >= scale {
+		return formatDecimal(rescale(unscaled, scale, places), places)
+	}
+
+	return formatDecimal(quoRound(unscaled, pow10(scale-places)), places)
+}
+
+// RoundCurrency returns d rounded to cents.
+func (d Decimal) RoundCurrency() Decimal {
+	return d.Round(2)
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	unscaled, scale := d.mustParse()
+	return formatDecimal(unscaled.Neg(unscaled), scale)
+}
+
+// Cmp compares d and e, returning -1, 0 or +1 as d is less than, equal to or
+// greater than e. Trailing zeros don't matter, so "1.50" equals "1.5".
+func (d Decimal) Cmp(e Decimal) int {
+	a, b, _ := align(d, e)
+	return a.Cmp(b)
+}
+
+// Sign returns -1, 0 or +1 as d is negative, zero or positive.
+func (d Decimal) Sign() int {
+	unscaled, _ := d.mustParse()
+	return unscaled.Sign()
+}
+
+// IsZero reports whether d is zero. The empty decimal is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Float64 returns the nearest float64 to d, like json.Number's method of the
+// same name.
+func (d Decimal) Float64() (float64, error) {
+	unscaled, scale, err := d.parse()
+	if err != nil {
+		return 0, err
+	}
+
+	f, _ := new(big.Rat).SetFrac(unscaled, pow10(scale)).Float64()
+	return f, nil
+}
+
+// Int64 returns d as an integer, like json.Number's method of the same name.
+// It fails if d has a fractional part or doesn't fit in an int64.
+func (d Decimal) Int64() (int64, error) {
+	unscaled, scale, err := d.parse()
+	if err != nil {
+		return 0, err
+	}
+
+	quo, rem := new(big.Int).QuoRem(unscaled, pow10(scale), new(big.Int))
+	if rem.Sign() != 0 || !quo.IsInt64() {
+		return 0, errors.New("not an int64: " + string(d))
+	}
+
+	return quo.Int64(), nil
+}
+
+// String returns the decimal text of d, or "0" for the empty decimal.
+func (d Decimal) String() string {
+	if d == "" {
+		return "0"
+	}
+
+	return string(d)
+}
+
+// MarshalJSON writes d as a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	unscaled, scale, err := d.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(formatDecimal(unscaled, scale)), nil
+}
+
+// UnmarshalJSON reads a JSON number or a string holding one. null leaves the
+// empty decimal.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		*d = ""
+		return nil
+	}
+
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+		if s == "" {
+			*d = ""
+			return nil
+		}
+	}
+
+	decimal, err := NewDecimal(s)
+	if err != nil {
+		return err
+	}
+
+	*d = decimal
+	return nil
+}
@@ -0,0 +1,123 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecimal(t *testing.T) {
+	cases := []struct {
+		in, expected string
+	}{
+		{"12.50", "12.50"},
+		{"-0.07", "-0.07"},
+		{"+3", "3"},
+		{".5", "0.5"},
+		{"1.5e3", "1500"},
+		{"125E-2", "1.25"},
+		{"1e3", "1000"},
+		{"2e-3", "0.002"},
+	}
+
+	for _, c := range cases {
+		d, err := NewDecimal(c.in)
+		require.NoError(t, err, c.in)
+		assert.Equal(t, Decimal(c.expected), d, c.in)
+	}
+
+	for _, in := range []string{"", "abc", "1.2.3", "-", "1e", "0x10", "1e999999999", "1e-999999999", "1e1001"} {
+		_, err := NewDecimal(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	assert.Equal(t, Decimal("0.30"), Decimal("0.1").Add("0.20"))
+	assert.Equal(t, Decimal("-0.1"), Decimal("0.1").Sub("0.2"))
+	assert.Equal(t, Decimal("2.5"), Decimal("").Add("2.5"))
+	assert.Equal(t, Decimal("1.0875"), Decimal("14.5").Mul("0.075"))
+	assert.Equal(t, Decimal("0.33"), Decimal("1").Div("3", 2))
+	assert.Equal(t, Decimal("-0.67"), Decimal("-2").Div("3", 2))
+	assert.Equal(t, Decimal("-2.50"), Decimal("5").Neg().Div("2", 2))
+	assert.Panics(t, func() { Decimal("1").Div("0", 2) })
+	assert.Panics(t, func() { Decimal("abc").Add("1") })
+
+	assert.Equal(t, 0, Decimal("1.50").Cmp("1.5"))
+	assert.Equal(t, -1, Decimal("-3").Cmp(""))
+	assert.Equal(t, 1, Decimal("0.001").Cmp("0"))
+	assert.True(t, Decimal("").IsZero())
+	assert.True(t, Decimal("-0.00").IsZero())
+}
+
+func TestDecimalRound(t *testing.T) {
+	cases := []struct {
+		in       Decimal
+		places   int
+		expected Decimal
+	}{
+		{"2.345", 2, "2.35"},
+		{"-2.345", 2, "-2.35"},
+		{"2.344", 2, "2.34"},
+		{"-0.004", 2, "0.00"},
+		{"7", 2, "7.00"},
+		{"1234.5", 0, "1235"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, c.in.Round(c.places), string(c.in))
+	}
+
+	assert.Equal(t, Decimal("0.10"), Decimal("0.1").RoundCurrency())
+}
+
+func TestDecimalConversions(t *testing.T) {
+	f, err := Decimal("12.25").Float64()
+	require.NoError(t, err)
+	assert.Equal(t, 12.25, f)
+
+	i, err := Decimal("42.00").Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), i)
+
+	_, err = Decimal("42.5").Int64()
+	assert.Error(t, err)
+
+	assert.Equal(t, Decimal("-7"), NewDecimalFromInt(-7))
+	assert.Equal(t, Decimal("0.1"), NewDecimalFromFloat(0.1))
+	assert.Equal(t, "0", Decimal("").String())
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		Amount   Decimal
+		Rate     Decimal
+		Qty      Decimal
+		Discount Decimal
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"Amount": 1500.10, "Rate": "0.075", "Qty": null, "Discount": 1e2}`), &v))
+	assert.Equal(t, Decimal("1500.10"), v.Amount)
+	assert.Equal(t, Decimal("0.075"), v.Rate)
+	assert.Equal(t, Decimal(""), v.Qty)
+	assert.Equal(t, Decimal("100"), v.Discount)
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Amount": 1500.10, "Rate": 0.075, "Qty": 0, "Discount": 100}`, string(b))
+
+	var omitted struct {
+		Amount Decimal `json:",omitempty"`
+		Zero   Decimal `json:",omitempty"`
+	}
+	omitted.Zero = "0"
+
+	b, err = json.Marshal(omitted)
+	require.NoError(t, err)
+	assert.Equal(t, `{"Zero":0}`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Amount": "ten"}`), &v))
+	assert.Error(t, json.Unmarshal([]byte(`{"Amount": 1e-999999999}`), &v))
+}
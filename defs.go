@@ -3,7 +3,19 @@
 
 package quickbooks
 
-import "time"
+import (
+	"time"
+)
+
+// BillableStatusEnum describes whether an expense or time entry can be billed
+// on to a customer.
+type BillableStatusEnum string
+
+const (
+	BillableStatusBillable      BillableStatusEnum = "Billable"
+	BillableStatusNotBillable   BillableStatusEnum = "NotBillable"
+	BillableStatusHasBeenBilled BillableStatusEnum = "HasBeenBilled"
+)
 
 type CustomField struct {
 	DefinitionId string `json:"DefinitionId,omitempty"`
@@ -12,26 +24,91 @@ type CustomField struct {
 	Name         string `json:"Name,omitempty"`
 }
 
-// Date represents a Quickbooks date
+// Date represents a QuickBooks date, such as a TxnDate or DueDate. Only the
+// calendar date in the Time's location matters; it is sent as YYYY-MM-DD,
+// which QuickBooks reads in the company's time zone. The zero Date is sent as
+// null, so optional dates are held as a *Date with omitempty to leave them
+// out of requests when unset.
 type Date struct {
 	time.Time `json:",omitempty"`
 }
 
-// UnmarshalJSON removes time from parsed date
-func (d *Date) UnmarshalJSON(b []byte) (err error) {
-	if b[0] == '"' && b[len(b)-1] == '"' {
-		b = b[1 : len(b)-1]
+// dateFormats are the layouts QuickBooks uses for dates and times.
+var dateFormats = []string{
+	time.RFC3339Nano,
+	secondFormat,
+	"2006-01-02Z07:00",
+}
+
+// parseDate parses a JSON date or time in any of the formats QuickBooks uses.
+// null and "" give the zero time.
+func parseDate(b []byte) (time.Time, error) {
+	s := string(b)
+	if s == "null" {
+		return time.Time{}, nil
+	}
+
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	if s == "" {
+		return time.Time{}, nil
 	}
 
-	d.Time, err = time.Parse(format, string(b))
-	if err != nil {
-		d.Time, err = time.Parse(secondFormat, string(b))
+	var err error
+	for _, layout := range dateFormats {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
 	}
 
+	return time.Time{}, err
+}
+
+// UnmarshalJSON reads a date, with or without a time and offset.
+func (d *Date) UnmarshalJSON(b []byte) (err error) {
+	d.Time, err = parseDate(b)
 	return err
 }
 
+// MarshalJSON writes the date as YYYY-MM-DD, or null if it is zero.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + d.Format(secondFormat) + `"`), nil
+}
+
 func (d Date) String() string {
+	return d.Format(secondFormat)
+}
+
+// DateTime represents a QuickBooks timestamp, such as MetaData.CreateTime.
+// Unlike Date, its time and offset are kept. The zero DateTime is sent as
+// null; optional timestamps are held as a *DateTime.
+type DateTime struct {
+	time.Time `json:",omitempty"`
+}
+
+// UnmarshalJSON reads a timestamp, or a date as midnight UTC.
+func (d *DateTime) UnmarshalJSON(b []byte) (err error) {
+	d.Time, err = parseDate(b)
+	return err
+}
+
+// MarshalJSON writes the timestamp with its offset, or null if it is zero.
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + d.Format(format) + `"`), nil
+}
+
+func (d DateTime) String() string {
 	return d.Format(format)
 }
 
@@ -69,8 +146,8 @@ type MemoRef struct {
 
 // MetaData is a timestamp of genesis and last change of a Quickbooks object
 type MetaData struct {
-	CreateTime      Date `json:",omitempty"`
-	LastUpdatedTime Date `json:",omitempty"`
+	CreateTime      *DateTime `json:",omitempty"`
+	LastUpdatedTime *DateTime `json:",omitempty"`
 }
 
 // PhysicalAddress represents a QuickBooks address.
@@ -107,3 +184,42 @@ type TelephoneNumber struct {
 type WebSiteAddress struct {
 	URI string `json:",omitempty"`
 }
+
+// Bool returns a pointer to b. Optional fields, such as Active, are pointers
+// so that a sparse update only sends the ones that are set, false included.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Int returns a pointer to i, for optional fields such as Term.DueDays.
+func Int(i int) *int {
+	return &i
+}
+
+// String returns a pointer to s, for optional fields such as Customer.Notes.
+func String(s string) *string {
+	return &s
+}
+
+// boolValue returns the value of an optional field, or false if it is unset.
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// intValue returns the value of an optional field, or 0 if it is unset.
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
+
+// stringValue returns the value of an optional field, or "" if it is unset.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
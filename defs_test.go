@@ -0,0 +1,69 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateUnmarshal(t *testing.T) {
+	cases := []struct {
+		in, expected string
+	}{
+		{`"2015-07-24"`, "2015-07-24"},
+		{`"2015-07-24T23:30:00-07:00"`, "2015-07-24"},
+		{`"2015-07-24T00:15:00.125+10:00"`, "2015-07-24"},
+		{`"2015-07-24Z"`, "2015-07-24"},
+		{`"2015-07-24-07:00"`, "2015-07-24"},
+	}
+
+	for _, c := range cases {
+		var d Date
+		require.NoError(t, json.Unmarshal([]byte(c.in), &d), c.in)
+		assert.Equal(t, c.expected, d.String(), c.in)
+	}
+
+	for _, in := range []string{`null`, `""`} {
+		var d Date
+		require.NoError(t, json.Unmarshal([]byte(in), &d), in)
+		assert.True(t, d.IsZero(), in)
+	}
+
+	var d Date
+	assert.Error(t, json.Unmarshal([]byte(`"24/07/2015"`), &d))
+}
+
+func TestDateMarshal(t *testing.T) {
+	invoice := Invoice{
+		Id:      "1",
+		TxnDate: &Date{time.Date(2015, 7, 24, 23, 30, 0, 0, time.FixedZone("PDT", -7*60*60))},
+	}
+
+	b, err := json.Marshal(invoice)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+
+	assert.JSONEq(t, `"2015-07-24"`, string(fields["TxnDate"]))
+	assert.NotContains(t, fields, "DueDate")
+	assert.NotContains(t, fields, "ShipDate")
+	assert.JSONEq(t, `{}`, string(fields["MetaData"]))
+}
+
+func TestDateTime(t *testing.T) {
+	var v MetaData
+
+	require.NoError(t, json.Unmarshal([]byte(`{"CreateTime": "2015-07-24T10:48:27.082-07:00"}`), &v))
+	require.NotNil(t, v.CreateTime)
+	assert.Equal(t, "2015-07-24T10:48:27-07:00", v.CreateTime.String())
+	assert.Equal(t, 17, v.CreateTime.UTC().Hour())
+	assert.Nil(t, v.LastUpdatedTime)
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"CreateTime": "2015-07-24T10:48:27-07:00"}`, string(b))
+}
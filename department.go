@@ -0,0 +1,189 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Department represents a QuickBooks Department, shown as a Location in the
+// QuickBooks UI, used to segment transactions for reporting.
+type Department struct {
+	Id                 string         `json:"Id,omitempty"`
+	SyncToken          string         `json:",omitempty"`
+	MetaData           MetaData       `json:",omitempty"`
+	Name               string         `json:",omitempty"`
+	SubDepartment      *bool          `json:",omitempty"`
+	ParentRef          *ReferenceType `json:",omitempty"`
+	FullyQualifiedName string         `json:",omitempty"`
+	Active             *bool          `json:",omitempty"`
+}
+
+// DepartmentNode is a department together with its direct children in the hierarchy.
+type DepartmentNode struct {
+	Department Department
+	Children   []*DepartmentNode
+}
+
+// BuildDepartmentTree arranges the given departments into a tree following their
+// ParentRef, returning the top-level departments in the order given. A department whose
+// parent isn't in the list, or whose parent would make a cycle, is treated as top-level.
+func BuildDepartmentTree(departments []Department) []*DepartmentNode {
+	nodes := make([]*DepartmentNode, len(departments))
+	ids := make([]string, len(departments))
+	parentIds := make([]string, len(departments))
+
+	for i := range departments {
+		nodes[i] = &DepartmentNode{Department: departments[i]}
+		ids[i] = departments[i].Id
+		if departments[i].ParentRef != nil {
+			parentIds[i] = departments[i].ParentRef.Value
+		}
+	}
+
+	var roots []*DepartmentNode
+
+	for i, parent := range treeParents(ids, parentIds) {
+		if parent < 0 {
+			roots = append(roots, nodes[i])
+			continue
+		}
+
+		nodes[parent].Children = append(nodes[parent].Children, nodes[i])
+	}
+
+	return roots
+}
+
+// CreateDepartment creates the given Department on the QuickBooks server, returning the
+// resulting Department object.
+func (c *Client) CreateDepartment(department *Department) (*Department, error) {
+	var resp struct {
+		Department Department
+		Time       DateTime
+	}
+
+	if err := c.post("department", department, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Department, nil
+}
+
+// DeactivateDepartment marks the department as inactive. QuickBooks doesn't allow departments
+// to be deleted.
+func (c *Client) DeactivateDepartment(department *Department) (*Department, error) {
+	if department.Id == "" {
+		return nil, errors.New("missing department id")
+	}
+
+	existingDepartment, err := c.FindDepartmentById(department.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := struct {
+		*Department
+		Sparse bool `json:"sparse"`
+	}{
+		Department: &Department{
+			Id:        existingDepartment.Id,
+			SyncToken: existingDepartment.SyncToken,
+			Name:      existingDepartment.Name,
+			Active:    Bool(false),
+		},
+		Sparse: true,
+	}
+
+	var departmentData struct {
+		Department Department
+		Time       DateTime
+	}
+
+	if err = c.post("department", payload, &departmentData, nil); err != nil {
+		return nil, err
+	}
+
+	return &departmentData.Department, err
+}
+
+// FindDepartments gets the full list of Departments in the QuickBooks account.
+func (c *Client) FindDepartments() ([]Department, error) {
+	var resp struct {
+		QueryResponse struct {
+			Departments   []Department `json:"Department"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM Department", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no departments could be found")
+	}
+
+	departments := make([]Department, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM Department ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.Departments == nil {
+			return nil, errors.New("no departments could be found")
+		}
+
+		departments = append(departments, resp.QueryResponse.Departments...)
+	}
+
+	return departments, nil
+}
+
+// FindDepartmentById finds the department by the given id
+func (c *Client) FindDepartmentById(id string) (*Department, error) {
+	var resp struct {
+		Department Department
+		Time       DateTime
+	}
+
+	if err := c.get("department/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Department, nil
+}
+
+// QueryDepartments accepts an SQL query and returns all departments found using it
+func (c *Client) QueryDepartments(query string) ([]Department, error) {
+	var resp struct {
+		QueryResponse struct {
+			Departments   []Department `json:"Department"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.Departments == nil {
+		return nil, errors.New("could not find any departments")
+	}
+
+	return resp.QueryResponse.Departments, nil
+}
+
+// UpdateDepartment updates the department
+func (c *Client) UpdateDepartment(department *Department, opts ...UpdateOption) (*Department, error) {
+	if department.Id == "" {
+		return nil, errors.New("missing department id")
+	}
+
+	return update(c, "department", "Department", department, c.FindDepartmentById, opts)
+}
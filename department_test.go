@@ -0,0 +1,28 @@
+package quickbooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDepartmentTree(t *testing.T) {
+	departments := []Department{
+		{Id: "2", Name: "Downtown", ParentRef: &ReferenceType{Value: "1"}},
+		{Id: "1", Name: "Sacramento"},
+		{Id: "3", Name: "Midtown", ParentRef: &ReferenceType{Value: "4"}},
+		{Id: "4", Name: "Loop", ParentRef: &ReferenceType{Value: "3"}},
+	}
+
+	roots := BuildDepartmentTree(departments)
+	require.Len(t, roots, 2)
+	assert.Equal(t, "Sacramento", roots[0].Department.Name)
+	require.Len(t, roots[0].Children, 1)
+	assert.Equal(t, "Downtown", roots[0].Children[0].Department.Name)
+
+	// Midtown and Loop name each other as parent; the second link is dropped.
+	assert.Equal(t, "Loop", roots[1].Department.Name)
+	require.Len(t, roots[1].Children, 1)
+	assert.Equal(t, "Midtown", roots[1].Children[0].Department.Name)
+}
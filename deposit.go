@@ -6,21 +6,22 @@ import (
 )
 
 type Deposit struct {
-	SyncToken           string        `json:",omitempty"`
-	Domain              string        `json:"domain,omitempty"`
-	DepositToAccountRef ReferenceType `json:",omitempty"`
-	TxnDate             Date          `json:",omitempty"`
-	TotalAmt            float64       `json:",omitempty"`
-	Line                []PaymentLine `json:",omitempty"`
-	Id                  string        `json:",omitempty"`
-	MetaData            MetaData      `json:",omitempty"`
+	SyncToken           string         `json:",omitempty"`
+	Domain              string         `json:"domain,omitempty"`
+	DepositToAccountRef ReferenceType  `json:",omitempty"`
+	TxnDate             *Date          `json:",omitempty"`
+	TotalAmt            Decimal        `json:",omitempty"`
+	Line                []PaymentLine  `json:",omitempty"`
+	Id                  string         `json:",omitempty"`
+	MetaData            MetaData       `json:",omitempty"`
+	RecurringInfo       *RecurringInfo `json:",omitempty"`
 }
 
 // CreateDeposit creates the given deposit within QuickBooks
 func (c *Client) CreateDeposit(deposit *Deposit) (*Deposit, error) {
 	var resp struct {
 		Deposit Deposit
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.post("deposit", deposit, &resp, nil); err != nil {
@@ -80,7 +81,7 @@ func (c *Client) FindDeposits() ([]Deposit, error) {
 func (c *Client) FindDepositById(id string) (*Deposit, error) {
 	var resp struct {
 		Deposit Deposit
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.get("deposit/"+id, &resp, nil); err != nil {
@@ -112,34 +113,10 @@ func (c *Client) QueryDeposits(query string) ([]Deposit, error) {
 }
 
 // UpdateDeposit updates the deposit
-func (c *Client) UpdateDeposit(deposit *Deposit) (*Deposit, error) {
+func (c *Client) UpdateDeposit(deposit *Deposit, opts ...UpdateOption) (*Deposit, error) {
 	if deposit.Id == "" {
 		return nil, errors.New("missing deposit id")
 	}
 
-	existingDeposit, err := c.FindDepositById(deposit.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	deposit.SyncToken = existingDeposit.SyncToken
-
-	payload := struct {
-		*Deposit
-		Sparse bool `json:"sparse"`
-	}{
-		Deposit: deposit,
-		Sparse:  true,
-	}
-
-	var depositData struct {
-		Deposit Deposit
-		Time    Date
-	}
-
-	if err = c.post("deposit", payload, &depositData, nil); err != nil {
-		return nil, err
-	}
-
-	return &depositData.Deposit, err
+	return update(c, "deposit", "Deposit", deposit, c.FindDepositById, opts)
 }
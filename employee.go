@@ -12,10 +12,10 @@ type Employee struct {
 	PrimaryPhone     TelephoneNumber `json:",omitempty"`
 	PrintOnCheckName string          `json:",omitempty"`
 	FamilyName       string          `json:",omitempty"`
-	Active           bool            `json:",omitempty"`
+	Active           *bool           `json:",omitempty"`
 	SSN              string          `json:",omitempty"`
 	PrimaryAddr      PhysicalAddress `json:",omitempty"`
-	BillableTime     bool            `json:",omitempty"`
+	BillableTime     *bool           `json:",omitempty"`
 	GivenName        string          `json:",omitempty"`
 	Id               string          `json:",omitempty"`
 	MetaData         MetaData        `json:",omitempty"`
@@ -25,7 +25,7 @@ type Employee struct {
 func (c *Client) CreateEmployee(employee *Employee) (*Employee, error) {
 	var resp struct {
 		Employee Employee
-		Time     Date
+		Time     DateTime
 	}
 
 	if err := c.post("employee", employee, &resp, nil); err != nil {
@@ -77,7 +77,7 @@ func (c *Client) FindEmployees() ([]Employee, error) {
 func (c *Client) FindEmployeeById(id string) (*Employee, error) {
 	var resp struct {
 		Employee Employee
-		Time     Date
+		Time     DateTime
 	}
 
 	if err := c.get("employee/"+id, &resp, nil); err != nil {
@@ -109,34 +109,10 @@ func (c *Client) QueryEmployees(query string) ([]Employee, error) {
 }
 
 // UpdateEmployee updates the employee
-func (c *Client) UpdateEmployee(employee *Employee) (*Employee, error) {
+func (c *Client) UpdateEmployee(employee *Employee, opts ...UpdateOption) (*Employee, error) {
 	if employee.Id == "" {
 		return nil, errors.New("missing employee id")
 	}
 
-	existingEmployee, err := c.FindEmployeeById(employee.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	employee.SyncToken = existingEmployee.SyncToken
-
-	payload := struct {
-		*Employee
-		Sparse bool `json:"sparse"`
-	}{
-		Employee: employee,
-		Sparse:   true,
-	}
-
-	var employeeData struct {
-		Employee Employee
-		Time     Date
-	}
-
-	if err = c.post("employee", payload, &employeeData, nil); err != nil {
-		return nil, err
-	}
-
-	return &employeeData.Employee, err
+	return update(c, "employee", "Employee", employee, c.FindEmployeeById, opts)
 }
@@ -11,8 +11,8 @@ type Estimate struct {
 	Domain                string          `json:"domain,omitempty"`
 	TxnStatus             string          `json:",omitempty"`
 	BillEmail             EmailAddress    `json:",omitempty"`
-	TxnDate               Date            `json:",omitempty"`
-	TotalAmt              float64         `json:",omitempty"`
+	TxnDate               *Date           `json:",omitempty"`
+	TotalAmt              Decimal         `json:",omitempty"`
 	CustomerRef           ReferenceType   `json:",omitempty"`
 	CustomerMemo          MemoRef         `json:",omitempty"`
 	ShipAddr              PhysicalAddress `json:",omitempty"`
@@ -20,11 +20,12 @@ type Estimate struct {
 	BillAddr              PhysicalAddress `json:",omitempty"`
 	EmailStatus           string          `json:",omitempty"`
 	Line                  []Line          `json:",omitempty"`
-	ApplyTaxAfterDiscount bool            `json:",omitempty"`
+	ApplyTaxAfterDiscount *bool           `json:",omitempty"`
 	CustomField           []CustomField   `json:",omitempty"`
 	Id                    string          `json:",omitempty"`
 	TxnTaxDetail          TxnTaxDetail    `json:",omitempty"`
 	MetaData              MetaData        `json:",omitempty"`
+	RecurringInfo         *RecurringInfo  `json:",omitempty"`
 }
 
 // CreateEstimate creates the given Estimate on the QuickBooks server, returning
@@ -32,7 +33,7 @@ type Estimate struct {
 func (c *Client) CreateEstimate(estimate *Estimate) (*Estimate, error) {
 	var resp struct {
 		Estimate Estimate
-		Time     Date
+		Time     DateTime
 	}
 
 	if err := c.post("estimate", estimate, &resp, nil); err != nil {
@@ -93,7 +94,7 @@ func (c *Client) FindEstimates() ([]Estimate, error) {
 func (c *Client) FindEstimateById(id string) (*Estimate, error) {
 	var resp struct {
 		Estimate Estimate
-		Time     Date
+		Time     DateTime
 	}
 
 	if err := c.get("estimate/"+id, &resp, nil); err != nil {
@@ -136,36 +137,12 @@ func (c *Client) SendEstimate(estimateId string, emailAddress string) error {
 }
 
 // UpdateEstimate updates the estimate
-func (c *Client) UpdateEstimate(estimate *Estimate) (*Estimate, error) {
+func (c *Client) UpdateEstimate(estimate *Estimate, opts ...UpdateOption) (*Estimate, error) {
 	if estimate.Id == "" {
 		return nil, errors.New("missing estimate id")
 	}
 
-	existingEstimate, err := c.FindEstimateById(estimate.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	estimate.SyncToken = existingEstimate.SyncToken
-
-	payload := struct {
-		*Estimate
-		Sparse bool `json:"sparse"`
-	}{
-		Estimate: estimate,
-		Sparse:   true,
-	}
-
-	var estimateData struct {
-		Estimate Estimate
-		Time     Date
-	}
-
-	if err = c.post("estimate", payload, &estimateData, nil); err != nil {
-		return nil, err
-	}
-
-	return &estimateData.Estimate, err
+	return update(c, "estimate", "Estimate", estimate, c.FindEstimateById, opts)
 }
 
 func (c *Client) VoidEstimate(estimate Estimate) error {
@@ -0,0 +1,150 @@
+package quickbooks
+
+import (
+	"errors"
+)
+
+// ExchangeRate is the rate used to convert a foreign currency into the home
+// currency on a given date.
+type ExchangeRate struct {
+	SyncToken          string   `json:",omitempty"`
+	MetaData           MetaData `json:",omitempty"`
+	SourceCurrencyCode string   `json:",omitempty"`
+	TargetCurrencyCode string   `json:",omitempty"`
+	Rate               Decimal  `json:",omitempty"`
+	AsOfDate           Date     `json:",omitempty"`
+}
+
+// GetExchangeRate returns the rate for converting sourceCurrency into the home
+// currency on the given date.
+func (c *Client) GetExchangeRate(sourceCurrency string, asOf Date) (*ExchangeRate, error) {
+	var resp struct {
+		ExchangeRate ExchangeRate
+		Time         DateTime
+	}
+
+	queryParameters := map[string]string{
+		"sourcecurrencycode": sourceCurrency,
+		"asofdate":           asOf.Format(secondFormat),
+	}
+
+	if err := c.get("exchangerate", &resp, queryParameters); err != nil {
+		return nil, err
+	}
+
+	return &resp.ExchangeRate, nil
+}
+
+// UpdateExchangeRate sets the rate for converting SourceCurrencyCode into the
+// home currency on AsOfDate.
+func (c *Client) UpdateExchangeRate(exchangeRate *ExchangeRate, opts ...UpdateOption) (*ExchangeRate, error) {
+	if exchangeRate.SourceCurrencyCode == "" || exchangeRate.AsOfDate.IsZero() {
+		return nil, errors.New("missing source currency code/as of date")
+	}
+
+	find := func() (interface{}, error) {
+		return c.GetExchangeRate(exchangeRate.SourceCurrencyCode, exchangeRate.AsOfDate)
+	}
+
+	if !newUpdateOptions(opts).keepSyncToken {
+		existingExchangeRate, err := c.GetExchangeRate(exchangeRate.SourceCurrencyCode, exchangeRate.AsOfDate)
+		if err != nil {
+			return nil, err
+		}
+
+		exchangeRate.SyncToken = existingExchangeRate.SyncToken
+	}
+
+	var exchangeRateData struct {
+		ExchangeRate ExchangeRate
+		Time         DateTime
+	}
+
+	if err := c.post("exchangerate", exchangeRate, &exchangeRateData, nil); err != nil {
+		return nil, conflictError(err, find)
+	}
+
+	return &exchangeRateData.ExchangeRate, nil
+}
+
+// HomeCurrencyAmount converts an amount in a foreign currency into the home
+// currency at the given exchange rate. Like QuickBooks, the result is rounded
+// to the nearest cent, with halves rounded away from zero.
+func HomeCurrencyAmount(amount Decimal, exchangeRate Decimal) (Decimal, error) {
+	if _, _, err := amount.parse(); err != nil {
+		return "", errors.New("invalid amount: " + string(amount))
+	}
+
+	if _, _, err := exchangeRate.parse(); err != nil {
+		return "", errors.New("invalid exchange rate: " + string(exchangeRate))
+	}
+
+	return amount.Mul(exchangeRate).RoundCurrency(), nil
+}
+
+// HomeCurrencyAmounts are the amounts of a foreign currency transaction
+// converted into the home currency.
+type HomeCurrencyAmounts struct {
+	// Lines holds the converted Amount of each line, in the same order. Lines
+	// that don't add to the total, such as subtotals, are left empty.
+	Lines   []Decimal
+	Tax     Decimal
+	Total   Decimal
+	Balance Decimal
+}
+
+// TransactionHomeCurrencyAmounts converts the lines, total tax and open
+// balance of a foreign currency transaction into the home currency at the
+// given exchange rate. Like QuickBooks, each line and the tax are converted
+// and rounded to the cent on their own, and the total is their sum, so it can
+// differ by a few cents from converting the foreign total. An open balance
+// equal to the whole transaction converts to the total; a part-paid one is
+// converted on its own.
+func TransactionHomeCurrencyAmounts(lines []Line, totalTax Decimal, balance Decimal, exchangeRate Decimal) (*HomeCurrencyAmounts, error) {
+	if exchangeRate == "" {
+		return nil, errors.New("missing exchange rate")
+	}
+
+	tax, err := HomeCurrencyAmount(totalTax, exchangeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := HomeCurrencyAmounts{
+		Lines: make([]Decimal, len(lines)),
+		Tax:   tax,
+		Total: tax,
+	}
+
+	foreignTotal := totalTax
+
+	for i, line := range lines {
+		if line.DetailType == SubTotalLineDetailType || line.Amount == "" {
+			continue
+		}
+
+		amount, err := HomeCurrencyAmount(line.Amount, exchangeRate)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts.Lines[i] = amount
+
+		// Discounts are sent as positive amounts but reduce the total.
+		if line.DetailType == DiscountLineDetailType {
+			amounts.Total = amounts.Total.Sub(amount)
+			foreignTotal = foreignTotal.Sub(line.Amount)
+		} else {
+			amounts.Total = amounts.Total.Add(amount)
+			foreignTotal = foreignTotal.Add(line.Amount)
+		}
+	}
+
+	if balance.Cmp(foreignTotal) == 0 {
+		amounts.Balance = amounts.Total
+	} else if amounts.Balance, err = HomeCurrencyAmount(balance, exchangeRate); err != nil {
+		return nil, err
+	}
+
+	return &amounts, nil
+}
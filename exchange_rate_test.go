@@ -0,0 +1,96 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRate(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/exchange_rate.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		ExchangeRate ExchangeRate
+		Time         DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "EUR", r.ExchangeRate.SourceCurrencyCode)
+	assert.Equal(t, "USD", r.ExchangeRate.TargetCurrencyCode)
+	assert.Equal(t, Decimal("1.09936"), r.ExchangeRate.Rate)
+	assert.Equal(t, "2015-07-07", r.ExchangeRate.AsOfDate.Format("2006-01-02"))
+
+	amount, err := HomeCurrencyAmount("100.00", r.ExchangeRate.Rate)
+	require.NoError(t, err)
+	assert.Equal(t, Decimal("109.94"), amount)
+}
+
+func TestHomeCurrencyAmount(t *testing.T) {
+	cases := []struct {
+		amount, rate, expected Decimal
+	}{
+		{"10.00", "1.5", "15.00"},
+		{"0.01", "0.5", "0.01"},
+		{"-0.01", "0.5", "-0.01"},
+		{"0.03", "0.5", "0.02"},
+		{"1234.56", "0.00001", "0.01"},
+		{"", "1.2", "0.00"},
+	}
+
+	for _, c := range cases {
+		amount, err := HomeCurrencyAmount(c.amount, c.rate)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, amount, "%s at %s", c.amount, c.rate)
+	}
+
+	_, err := HomeCurrencyAmount("10", "abc")
+	assert.Error(t, err)
+}
+
+func TestInvoiceSetHomeCurrencyAmounts(t *testing.T) {
+	item := SalesItemLineDetail{ItemRef: ReferenceType{Value: "5"}}
+
+	invoice := Invoice{
+		Line: []Line{
+			NewSalesItemLine("1.01", item),
+			NewSalesItemLine("1.01", item),
+			NewSalesItemLine("1.01", item),
+			NewDiscountLine("0.21", DiscountLineDetail{}),
+			NewDescriptionLine("Thank you"),
+			NewSubTotalLine(),
+		},
+		TxnTaxDetail: TxnTaxDetail{TotalTax: "0.30"},
+		TotalAmt:     "3.12",
+		Balance:      "3.12",
+		ExchangeRate: "1.5",
+	}
+	invoice.Line[5].Amount = "2.82"
+
+	amounts, err := TransactionHomeCurrencyAmounts(invoice.Line, invoice.TxnTaxDetail.TotalTax, invoice.Balance, invoice.ExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, []Decimal{"1.52", "1.52", "1.52", "0.32", "", ""}, amounts.Lines)
+	assert.Equal(t, Decimal("0.45"), amounts.Tax)
+
+	// Converting the total of 3.12 directly would give 4.68.
+	require.NoError(t, invoice.SetHomeCurrencyAmounts())
+	assert.Equal(t, Decimal("4.69"), invoice.HomeAmtTotal)
+	assert.Equal(t, Decimal("4.69"), invoice.HomeBalance)
+
+	invoice.Balance = "1.01"
+	require.NoError(t, invoice.SetHomeCurrencyAmounts())
+	assert.Equal(t, Decimal("4.69"), invoice.HomeAmtTotal)
+	assert.Equal(t, Decimal("1.52"), invoice.HomeBalance)
+
+	invoice.ExchangeRate = ""
+	assert.Error(t, invoice.SetHomeCurrencyAmounts())
+}
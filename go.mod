@@ -5,7 +5,6 @@ go 1.20
 require (
 	github.com/stretchr/testify v1.9.0
 	golang.org/x/oauth2 v0.19.0
-	gopkg.in/guregu/null.v4 v4.0.0
 )
 
 require (
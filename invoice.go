@@ -4,7 +4,6 @@
 package quickbooks
 
 import (
-	"encoding/json"
 	"errors"
 	"strconv"
 )
@@ -16,9 +15,9 @@ type Invoice struct {
 	MetaData      MetaData      `json:",omitempty"`
 	CustomField   []CustomField `json:",omitempty"`
 	DocNumber     string        `json:",omitempty"`
-	TxnDate       Date          `json:",omitempty"`
+	TxnDate       *Date         `json:",omitempty"`
 	DepartmentRef ReferenceType `json:",omitempty"`
-	PrivateNote   string        `json:",omitempty"`
+	PrivateNote   *string       `json:",omitempty"`
 	LinkedTxn     []LinkedTxn   `json:"LinkedTxn"`
 	Line          []Line
 	TxnTaxDetail  TxnTaxDetail `json:",omitempty"`
@@ -28,34 +27,35 @@ type Invoice struct {
 	ShipAddr      PhysicalAddress `json:",omitempty"`
 	ClassRef      ReferenceType   `json:",omitempty"`
 	SalesTermRef  ReferenceType   `json:",omitempty"`
-	DueDate       Date            `json:",omitempty"`
+	DueDate       *Date           `json:",omitempty"`
 	// GlobalTaxCalculation
-	ShipMethodRef                ReferenceType `json:",omitempty"`
-	ShipDate                     Date          `json:",omitempty"`
-	TrackingNum                  string        `json:",omitempty"`
-	TotalAmt                     json.Number   `json:",omitempty"`
-	CurrencyRef                  ReferenceType `json:",omitempty"`
-	ExchangeRate                 json.Number   `json:",omitempty"`
-	HomeAmtTotal                 json.Number   `json:",omitempty"`
-	HomeBalance                  json.Number   `json:",omitempty"`
-	ApplyTaxAfterDiscount        bool          `json:",omitempty"`
-	PrintStatus                  string        `json:",omitempty"`
-	EmailStatus                  string        `json:",omitempty"`
-	BillEmail                    EmailAddress  `json:",omitempty"`
-	BillEmailCC                  EmailAddress  `json:"BillEmailCc,omitempty"`
-	BillEmailBCC                 EmailAddress  `json:"BillEmailBcc,omitempty"`
-	DeliveryInfo                 *DeliveryInfo `json:",omitempty"`
-	Balance                      json.Number   `json:",omitempty"`
-	TxnSource                    string        `json:",omitempty"`
-	AllowOnlineCreditCardPayment bool          `json:",omitempty"`
-	AllowOnlineACHPayment        bool          `json:",omitempty"`
-	Deposit                      json.Number   `json:",omitempty"`
-	DepositToAccountRef          ReferenceType `json:",omitempty"`
+	ShipMethodRef                ReferenceType  `json:",omitempty"`
+	ShipDate                     *Date          `json:",omitempty"`
+	TrackingNum                  string         `json:",omitempty"`
+	TotalAmt                     Decimal        `json:",omitempty"`
+	CurrencyRef                  ReferenceType  `json:",omitempty"`
+	ExchangeRate                 Decimal        `json:",omitempty"`
+	HomeAmtTotal                 Decimal        `json:",omitempty"`
+	HomeBalance                  Decimal        `json:",omitempty"`
+	ApplyTaxAfterDiscount        *bool          `json:",omitempty"`
+	PrintStatus                  string         `json:",omitempty"`
+	EmailStatus                  string         `json:",omitempty"`
+	BillEmail                    EmailAddress   `json:",omitempty"`
+	BillEmailCC                  EmailAddress   `json:"BillEmailCc,omitempty"`
+	BillEmailBCC                 EmailAddress   `json:"BillEmailBcc,omitempty"`
+	DeliveryInfo                 *DeliveryInfo  `json:",omitempty"`
+	Balance                      Decimal        `json:",omitempty"`
+	TxnSource                    string         `json:",omitempty"`
+	AllowOnlineCreditCardPayment *bool          `json:",omitempty"`
+	AllowOnlineACHPayment        *bool          `json:",omitempty"`
+	Deposit                      Decimal        `json:",omitempty"`
+	DepositToAccountRef          ReferenceType  `json:",omitempty"`
+	RecurringInfo                *RecurringInfo `json:",omitempty"`
 }
 
 type DeliveryInfo struct {
 	DeliveryType string
-	DeliveryTime Date
+	DeliveryTime DateTime
 }
 
 type LinkedTxn struct {
@@ -65,62 +65,23 @@ type LinkedTxn struct {
 
 type TxnTaxDetail struct {
 	TxnTaxCodeRef ReferenceType `json:",omitempty"`
-	TotalTax      json.Number   `json:",omitempty"`
+	TotalTax      Decimal       `json:",omitempty"`
 	TaxLine       []Line        `json:",omitempty"`
 }
 
-type AccountBasedExpenseLineDetail struct {
-	AccountRef ReferenceType
-	TaxAmount  json.Number `json:",omitempty"`
-	// TaxInclusiveAmt json.Number              `json:",omitempty"`
-	// ClassRef        ReferenceType `json:",omitempty"`
-	// TaxCodeRef      ReferenceType `json:",omitempty"`
-	// MarkupInfo MarkupInfo `json:",omitempty"`
-	// BillableStatus BillableStatusEnum       `json:",omitempty"`
-	// CustomerRef    ReferenceType `json:",omitempty"`
-}
-
-type Line struct {
-	Id                            string `json:",omitempty"`
-	LineNum                       int    `json:",omitempty"`
-	Description                   string `json:",omitempty"`
-	Amount                        json.Number
-	DetailType                    string
-	AccountBasedExpenseLineDetail AccountBasedExpenseLineDetail `json:",omitempty"`
-	SalesItemLineDetail           SalesItemLineDetail           `json:",omitempty"`
-	DiscountLineDetail            DiscountLineDetail            `json:",omitempty"`
-	TaxLineDetail                 TaxLineDetail                 `json:",omitempty"`
-}
-
-// TaxLineDetail ...
-type TaxLineDetail struct {
-	PercentBased     bool        `json:",omitempty"`
-	NetAmountTaxable json.Number `json:",omitempty"`
-	// TaxInclusiveAmount json.Number `json:",omitempty"`
-	// OverrideDeltaAmount
-	TaxPercent json.Number `json:",omitempty"`
-	TaxRateRef ReferenceType
-}
+// SetHomeCurrencyAmounts sets HomeAmtTotal and HomeBalance from the
+// invoice's lines, tax, Balance and ExchangeRate, as QuickBooks would. See
+// TransactionHomeCurrencyAmounts.
+func (i *Invoice) SetHomeCurrencyAmounts() error {
+	amounts, err := TransactionHomeCurrencyAmounts(i.Line, i.TxnTaxDetail.TotalTax, i.Balance, i.ExchangeRate)
+	if err != nil {
+		return err
+	}
 
-// SalesItemLineDetail ...
-type SalesItemLineDetail struct {
-	ItemRef   ReferenceType `json:",omitempty"`
-	ClassRef  ReferenceType `json:",omitempty"`
-	UnitPrice json.Number   `json:",omitempty"`
-	// MarkupInfo
-	Qty             float32       `json:",omitempty"`
-	ItemAccountRef  ReferenceType `json:",omitempty"`
-	TaxCodeRef      ReferenceType `json:",omitempty"`
-	ServiceDate     Date          `json:",omitempty"`
-	TaxInclusiveAmt json.Number   `json:",omitempty"`
-	DiscountRate    json.Number   `json:",omitempty"`
-	DiscountAmt     json.Number   `json:",omitempty"`
-}
+	i.HomeAmtTotal = amounts.Total
+	i.HomeBalance = amounts.Balance
 
-// DiscountLineDetail ...
-type DiscountLineDetail struct {
-	PercentBased    bool
-	DiscountPercent float32 `json:",omitempty"`
+	return nil
 }
 
 // CreateInvoice creates the given Invoice on the QuickBooks server, returning
@@ -128,7 +89,7 @@ type DiscountLineDetail struct {
 func (c *Client) CreateInvoice(invoice *Invoice) (*Invoice, error) {
 	var resp struct {
 		Invoice Invoice
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.post("invoice", invoice, &resp, nil); err != nil {
@@ -197,7 +158,7 @@ func (c *Client) FindInvoices() ([]Invoice, error) {
 func (c *Client) FindInvoiceById(id string) (*Invoice, error) {
 	var resp struct {
 		Invoice Invoice
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.get("invoice/"+id, &resp, nil); err != nil {
@@ -240,36 +201,12 @@ func (c *Client) SendInvoice(invoiceId string, emailAddress string) error {
 }
 
 // UpdateInvoice updates the invoice
-func (c *Client) UpdateInvoice(invoice *Invoice) (*Invoice, error) {
+func (c *Client) UpdateInvoice(invoice *Invoice, opts ...UpdateOption) (*Invoice, error) {
 	if invoice.Id == "" {
 		return nil, errors.New("missing invoice id")
 	}
 
-	existingInvoice, err := c.FindInvoiceById(invoice.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	invoice.SyncToken = existingInvoice.SyncToken
-
-	payload := struct {
-		*Invoice
-		Sparse bool `json:"sparse"`
-	}{
-		Invoice: invoice,
-		Sparse:  true,
-	}
-
-	var invoiceData struct {
-		Invoice Invoice
-		Time    Date
-	}
-
-	if err = c.post("invoice", payload, &invoiceData, nil); err != nil {
-		return nil, err
-	}
-
-	return &invoiceData.Invoice, err
+	return update(c, "invoice", "Invoice", invoice, c.FindInvoiceById, opts)
 }
 
 func (c *Client) VoidInvoice(invoice Invoice) error {
@@ -0,0 +1,78 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvoiceGroupLines(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/invoice_group.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Invoice Invoice
+		Time    DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+	require.Len(t, r.Invoice.Line, 3)
+
+	group := r.Invoice.Line[0]
+	assert.Equal(t, GroupLineDetailType, group.DetailType)
+	require.NotNil(t, group.GroupLineDetail)
+	assert.Equal(t, "19", group.GroupLineDetail.GroupItemRef.Value)
+	assert.Equal(t, Decimal("2"), group.GroupLineDetail.Quantity)
+	require.Len(t, group.GroupLineDetail.Line, 2)
+	require.NotNil(t, group.GroupLineDetail.Line[0].SalesItemLineDetail)
+	assert.Equal(t, "14", group.GroupLineDetail.Line[0].SalesItemLineDetail.ItemRef.Value)
+	assert.Equal(t, Decimal("10"), group.GroupLineDetail.Line[0].SalesItemLineDetail.Qty)
+
+	description := r.Invoice.Line[1]
+	assert.Equal(t, DescriptionOnlyLineDetailType, description.DetailType)
+	assert.Equal(t, "Delivered to the back entrance.", description.Description)
+	require.NotNil(t, description.DescriptionLineDetail)
+	assert.Equal(t, "NON", description.DescriptionLineDetail.TaxCodeRef.Value)
+
+	subTotal := r.Invoice.Line[2]
+	assert.Equal(t, SubTotalLineDetailType, subTotal.DetailType)
+	assert.NotNil(t, subTotal.SubTotalLineDetail)
+	assert.Equal(t, Decimal("190.0"), subTotal.Amount)
+
+	// Each line is sent back exactly as it was received.
+	var raw struct {
+		Invoice struct {
+			Line []json.RawMessage
+		}
+	}
+	require.NoError(t, json.Unmarshal(byteValue, &raw))
+
+	for i, line := range r.Invoice.Line {
+		b, err := json.Marshal(line)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(raw.Invoice.Line[i]), string(b))
+	}
+}
+
+func TestNewGroupLine(t *testing.T) {
+	b, err := json.Marshal([]Line{
+		NewGroupLine(ReferenceType{Value: "19"}, "2"),
+		NewDescriptionLine("Thank you!"),
+		NewSubTotalLine(),
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{"DetailType": "GroupLineDetail", "GroupLineDetail": {"GroupItemRef": {"value": "19"}, "Quantity": 2}},
+		{"Description": "Thank you!", "DetailType": "DescriptionOnly", "DescriptionLineDetail": {}},
+		{"DetailType": "SubTotalLineDetail", "SubTotalLineDetail": {}}
+	]`, string(b))
+}
@@ -4,7 +4,6 @@
 package quickbooks
 
 import (
-	"encoding/json"
 	"errors"
 	"strconv"
 )
@@ -15,26 +14,26 @@ type Item struct {
 	SyncToken   string   `json:",omitempty"`
 	MetaData    MetaData `json:",omitempty"`
 	Name        string
-	SKU         string `json:"Sku,omitempty"`
-	Description string `json:",omitempty"`
-	Active      bool   `json:",omitempty"`
+	SKU         string  `json:"Sku,omitempty"`
+	Description *string `json:",omitempty"`
+	Active      *bool   `json:",omitempty"`
 	// SubItem
 	// ParentRef
 	// Level
 	// FullyQualifiedName
-	Taxable             bool        `json:",omitempty"`
-	SalesTaxIncluded    bool        `json:",omitempty"`
-	UnitPrice           json.Number `json:",omitempty"`
+	Taxable             *bool   `json:",omitempty"`
+	SalesTaxIncluded    *bool   `json:",omitempty"`
+	UnitPrice           Decimal `json:",omitempty"`
 	Type                string
 	IncomeAccountRef    ReferenceType
 	ExpenseAccountRef   ReferenceType
-	PurchaseDesc        string      `json:",omitempty"`
-	PurchaseTaxIncluded bool        `json:",omitempty"`
-	PurchaseCost        json.Number `json:",omitempty"`
+	PurchaseDesc        string  `json:",omitempty"`
+	PurchaseTaxIncluded *bool   `json:",omitempty"`
+	PurchaseCost        Decimal `json:",omitempty"`
 	AssetAccountRef     ReferenceType
-	TrackQtyOnHand      bool `json:",omitempty"`
+	TrackQtyOnHand      *bool `json:",omitempty"`
 	// InvStartDate Date
-	QtyOnHand          json.Number   `json:",omitempty"`
+	QtyOnHand          Decimal       `json:",omitempty"`
 	SalesTaxCodeRef    ReferenceType `json:",omitempty"`
 	PurchaseTaxCodeRef ReferenceType `json:",omitempty"`
 }
@@ -42,7 +41,7 @@ type Item struct {
 func (c *Client) CreateItem(item *Item) (*Item, error) {
 	var resp struct {
 		Item Item
-		Time Date
+		Time DateTime
 	}
 
 	if err := c.post("item", item, &resp, nil); err != nil {
@@ -94,7 +93,7 @@ func (c *Client) FindItems() ([]Item, error) {
 func (c *Client) FindItemById(id string) (*Item, error) {
 	var resp struct {
 		Item Item
-		Time Date
+		Time DateTime
 	}
 
 	if err := c.get("item/"+id, &resp, nil); err != nil {
@@ -126,34 +125,10 @@ func (c *Client) QueryItems(query string) ([]Item, error) {
 }
 
 // UpdateItem updates the item
-func (c *Client) UpdateItem(item *Item) (*Item, error) {
+func (c *Client) UpdateItem(item *Item, opts ...UpdateOption) (*Item, error) {
 	if item.Id == "" {
 		return nil, errors.New("missing item id")
 	}
 
-	existingItem, err := c.FindItemById(item.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	item.SyncToken = existingItem.SyncToken
-
-	payload := struct {
-		*Item
-		Sparse bool `json:"sparse"`
-	}{
-		Item:   item,
-		Sparse: true,
-	}
-
-	var itemData struct {
-		Item Item
-		Time Date
-	}
-
-	if err = c.post("item", payload, &itemData, nil); err != nil {
-		return nil, err
-	}
-
-	return &itemData.Item, err
+	return update(c, "item", "Item", item, c.FindItemById, opts)
 }
@@ -0,0 +1,323 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Line detail types. A Line's DetailType says which of its detail fields is
+// in use.
+const (
+	AccountBasedExpenseLineDetailType = "AccountBasedExpenseLineDetail"
+	ItemBasedExpenseLineDetailType    = "ItemBasedExpenseLineDetail"
+	SalesItemLineDetailType           = "SalesItemLineDetail"
+	DiscountLineDetailType            = "DiscountLineDetail"
+	TaxLineDetailType                 = "TaxLineDetail"
+	GroupLineDetailType               = "GroupLineDetail"
+	SubTotalLineDetailType            = "SubTotalLineDetail"
+	DescriptionOnlyLineDetailType     = "DescriptionOnly"
+)
+
+// Line is a line of a transaction. Only the detail named by DetailType is
+// sent to QuickBooks; use the New*Line constructors to build lines with a
+// matching type and detail. Details of types this package doesn't know are
+// kept as they were received and sent back unchanged.
+type Line struct {
+	Id          string  `json:",omitempty"`
+	LineNum     int     `json:",omitempty"`
+	Description string  `json:",omitempty"`
+	Amount      Decimal `json:",omitempty"`
+	// LinkedTxn links the line to the transactions it was made from, such as
+	// the time activity an invoice line bills.
+	LinkedTxn                     []LinkedTxn `json:",omitempty"`
+	DetailType                    string
+	AccountBasedExpenseLineDetail *AccountBasedExpenseLineDetail `json:",omitempty"`
+	ItemBasedExpenseLineDetail    *ItemBasedExpenseLineDetail    `json:",omitempty"`
+	SalesItemLineDetail           *SalesItemLineDetail           `json:",omitempty"`
+	DiscountLineDetail            *DiscountLineDetail            `json:",omitempty"`
+	TaxLineDetail                 *TaxLineDetail                 `json:",omitempty"`
+	GroupLineDetail               *GroupLineDetail               `json:",omitempty"`
+	SubTotalLineDetail            *SubTotalLineDetail            `json:",omitempty"`
+	// DescriptionLineDetail goes with the DescriptionOnly detail type.
+	DescriptionLineDetail *DescriptionLineDetail `json:",omitempty"`
+
+	// unknown holds the fields of the line that aren't modelled above, such
+	// as the detail of an unknown DetailType.
+	unknown map[string]json.RawMessage
+}
+
+// AccountBasedExpenseLineDetail is the detail of an expense line posted
+// straight to an account. A billable expense names the customer to rebill.
+type AccountBasedExpenseLineDetail struct {
+	AccountRef      ReferenceType
+	TaxAmount       Decimal            `json:",omitempty"`
+	TaxInclusiveAmt Decimal            `json:",omitempty"`
+	ClassRef        *ReferenceType     `json:",omitempty"`
+	TaxCodeRef      *ReferenceType     `json:",omitempty"`
+	MarkupInfo      *MarkupInfo        `json:",omitempty"`
+	BillableStatus  BillableStatusEnum `json:",omitempty"`
+	CustomerRef     *ReferenceType     `json:",omitempty"`
+}
+
+// ItemBasedExpenseLineDetail is the detail of an expense line that purchases
+// an item, as found on bills and vendor credits. A billable expense names the
+// customer to rebill.
+type ItemBasedExpenseLineDetail struct {
+	ItemRef         ReferenceType      `json:",omitempty"`
+	ClassRef        *ReferenceType     `json:",omitempty"`
+	UnitPrice       Decimal            `json:",omitempty"`
+	Qty             Decimal            `json:",omitempty"`
+	TaxCodeRef      *ReferenceType     `json:",omitempty"`
+	TaxInclusiveAmt Decimal            `json:",omitempty"`
+	CustomerRef     *ReferenceType     `json:",omitempty"`
+	BillableStatus  BillableStatusEnum `json:",omitempty"`
+	MarkupInfo      *MarkupInfo        `json:",omitempty"`
+	PriceLevelRef   *ReferenceType     `json:",omitempty"`
+}
+
+// MarkupInfo is the markup applied when a billable expense is charged on to
+// a customer.
+type MarkupInfo struct {
+	PercentBased           bool           `json:",omitempty"`
+	Value                  Decimal        `json:",omitempty"`
+	Percent                Decimal        `json:",omitempty"`
+	PriceLevelRef          *ReferenceType `json:",omitempty"`
+	MarkUpIncomeAccountRef *ReferenceType `json:",omitempty"`
+}
+
+// TaxLineDetail ...
+type TaxLineDetail struct {
+	PercentBased     bool    `json:",omitempty"`
+	NetAmountTaxable Decimal `json:",omitempty"`
+	// TaxInclusiveAmount Decimal `json:",omitempty"`
+	// OverrideDeltaAmount
+	TaxPercent Decimal `json:",omitempty"`
+	TaxRateRef ReferenceType
+}
+
+// SalesItemLineDetail ...
+type SalesItemLineDetail struct {
+	ItemRef         ReferenceType  `json:",omitempty"`
+	ClassRef        *ReferenceType `json:",omitempty"`
+	UnitPrice       Decimal        `json:",omitempty"`
+	MarkupInfo      *MarkupInfo    `json:",omitempty"`
+	Qty             Decimal        `json:",omitempty"`
+	ItemAccountRef  *ReferenceType `json:",omitempty"`
+	TaxCodeRef      *ReferenceType `json:",omitempty"`
+	ServiceDate     *Date          `json:",omitempty"`
+	TaxInclusiveAmt Decimal        `json:",omitempty"`
+	DiscountRate    Decimal        `json:",omitempty"`
+	DiscountAmt     Decimal        `json:",omitempty"`
+}
+
+// DiscountLineDetail ...
+type DiscountLineDetail struct {
+	PercentBased    bool
+	DiscountPercent Decimal `json:",omitempty"`
+}
+
+// GroupLineDetail is the detail of a line that sells a bundle. The bundle's
+// items are nested in Line.
+type GroupLineDetail struct {
+	GroupItemRef ReferenceType
+	Quantity     Decimal `json:",omitempty"`
+	Line         []Line  `json:",omitempty"`
+}
+
+// SubTotalLineDetail is the detail of a line that subtotals the lines above
+// it. QuickBooks computes the amount.
+type SubTotalLineDetail struct {
+	ItemRef *ReferenceType `json:",omitempty"`
+}
+
+// DescriptionLineDetail is the detail of a line that only holds text.
+type DescriptionLineDetail struct {
+	ServiceDate *Date          `json:",omitempty"`
+	TaxCodeRef  *ReferenceType `json:",omitempty"`
+}
+
+// NewAccountBasedExpenseLine returns an expense line posted to an account.
+func NewAccountBasedExpenseLine(amount Decimal, detail AccountBasedExpenseLineDetail) Line {
+	return Line{
+		Amount:                        amount,
+		DetailType:                    AccountBasedExpenseLineDetailType,
+		AccountBasedExpenseLineDetail: &detail,
+	}
+}
+
+// NewItemBasedExpenseLine returns an expense line that purchases an item.
+func NewItemBasedExpenseLine(amount Decimal, detail ItemBasedExpenseLineDetail) Line {
+	return Line{
+		Amount:                     amount,
+		DetailType:                 ItemBasedExpenseLineDetailType,
+		ItemBasedExpenseLineDetail: &detail,
+	}
+}
+
+// NewSalesItemLine returns a sales line that sells an item.
+func NewSalesItemLine(amount Decimal, detail SalesItemLineDetail) Line {
+	return Line{
+		Amount:              amount,
+		DetailType:          SalesItemLineDetailType,
+		SalesItemLineDetail: &detail,
+	}
+}
+
+// NewDiscountLine returns a discount line for a sales transaction.
+func NewDiscountLine(amount Decimal, detail DiscountLineDetail) Line {
+	return Line{
+		Amount:             amount,
+		DetailType:         DiscountLineDetailType,
+		DiscountLineDetail: &detail,
+	}
+}
+
+// NewTaxLine returns a tax line, as found in a TxnTaxDetail.
+func NewTaxLine(amount Decimal, detail TaxLineDetail) Line {
+	return Line{
+		Amount:        amount,
+		DetailType:    TaxLineDetailType,
+		TaxLineDetail: &detail,
+	}
+}
+
+// NewGroupLine returns a sales line that sells quantity of the bundle
+// groupItemRef. QuickBooks fills in the bundle's lines and the amount.
+func NewGroupLine(groupItemRef ReferenceType, quantity Decimal) Line {
+	return Line{
+		DetailType: GroupLineDetailType,
+		GroupLineDetail: &GroupLineDetail{
+			GroupItemRef: groupItemRef,
+			Quantity:     quantity,
+		},
+	}
+}
+
+// NewSubTotalLine returns a line that subtotals the sales lines above it.
+func NewSubTotalLine() Line {
+	return Line{
+		DetailType:         SubTotalLineDetailType,
+		SubTotalLineDetail: &SubTotalLineDetail{},
+	}
+}
+
+// NewDescriptionLine returns a line that only holds the given text.
+func NewDescriptionLine(description string) Line {
+	return Line{
+		Description:           description,
+		DetailType:            DescriptionOnlyLineDetailType,
+		DescriptionLineDetail: &DescriptionLineDetail{},
+	}
+}
+
+// lineFields are the JSON keys of the fields modelled by Line.
+var lineFields = map[string]bool{
+	"Id":                              true,
+	"LineNum":                         true,
+	"Description":                     true,
+	"Amount":                          true,
+	"LinkedTxn":                       true,
+	"DetailType":                      true,
+	AccountBasedExpenseLineDetailType: true,
+	ItemBasedExpenseLineDetailType:    true,
+	SalesItemLineDetailType:           true,
+	DiscountLineDetailType:            true,
+	TaxLineDetailType:                 true,
+	GroupLineDetailType:               true,
+	SubTotalLineDetailType:            true,
+	"DescriptionLineDetail":           true,
+}
+
+// MarshalJSON writes the line with only the detail named by DetailType.
+func (l Line) MarshalJSON() ([]byte, error) {
+	type line Line
+
+	out := line{
+		Id:          l.Id,
+		LineNum:     l.LineNum,
+		Description: l.Description,
+		Amount:      l.Amount,
+		LinkedTxn:   l.LinkedTxn,
+		DetailType:  l.DetailType,
+	}
+
+	var missing bool
+
+	switch l.DetailType {
+	case AccountBasedExpenseLineDetailType:
+		out.AccountBasedExpenseLineDetail = l.AccountBasedExpenseLineDetail
+		missing = l.AccountBasedExpenseLineDetail == nil
+	case ItemBasedExpenseLineDetailType:
+		out.ItemBasedExpenseLineDetail = l.ItemBasedExpenseLineDetail
+		missing = l.ItemBasedExpenseLineDetail == nil
+	case SalesItemLineDetailType:
+		out.SalesItemLineDetail = l.SalesItemLineDetail
+		missing = l.SalesItemLineDetail == nil
+	case DiscountLineDetailType:
+		out.DiscountLineDetail = l.DiscountLineDetail
+		missing = l.DiscountLineDetail == nil
+	case TaxLineDetailType:
+		out.TaxLineDetail = l.TaxLineDetail
+		missing = l.TaxLineDetail == nil
+	case GroupLineDetailType:
+		out.GroupLineDetail = l.GroupLineDetail
+		missing = l.GroupLineDetail == nil
+	case SubTotalLineDetailType:
+		// The detail is optional for subtotals and descriptions.
+		out.SubTotalLineDetail = l.SubTotalLineDetail
+	case DescriptionOnlyLineDetailType:
+		out.DescriptionLineDetail = l.DescriptionLineDetail
+	case "":
+		return nil, errors.New("line is missing its detail type")
+	}
+
+	if missing {
+		return nil, errors.New("line of type " + l.DetailType + " is missing its detail")
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil || len(l.unknown) == 0 {
+		return b, err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range l.unknown {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON reads a line, keeping any fields it doesn't model.
+func (l *Line) UnmarshalJSON(b []byte) error {
+	type line Line
+
+	var in line
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	for key := range fields {
+		if lineFields[key] {
+			delete(fields, key)
+		}
+	}
+
+	*l = Line(in)
+	if len(fields) > 0 {
+		l.unknown = fields
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLine(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/line.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Line []Line
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+	require.Len(t, r.Line, 2)
+
+	assert.Equal(t, SalesItemLineDetailType, r.Line[0].DetailType)
+	require.NotNil(t, r.Line[0].SalesItemLineDetail)
+	assert.Equal(t, "5", r.Line[0].SalesItemLineDetail.ItemRef.Value)
+	assert.Nil(t, r.Line[0].AccountBasedExpenseLineDetail)
+
+	assert.Equal(t, "DepositLineDetail", r.Line[1].DetailType)
+	assert.Equal(t, Decimal("10.0"), r.Line[1].Amount)
+	assert.Equal(t, []LinkedTxn{{TxnID: "166", TxnType: "Payment"}}, r.Line[1].LinkedTxn)
+
+	// Lines of unknown types are sent back as they were received.
+	var raw struct {
+		Line []json.RawMessage
+	}
+	require.NoError(t, json.Unmarshal(byteValue, &raw))
+
+	b, err := json.Marshal(r.Line[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, string(raw.Line[1]), string(b))
+}
+
+func TestLineMarshalOnlyMatchingDetail(t *testing.T) {
+	line := NewSalesItemLine("20.00", SalesItemLineDetail{
+		ItemRef: ReferenceType{Value: "7"},
+		Qty:     "2",
+	})
+	line.AccountBasedExpenseLineDetail = &AccountBasedExpenseLineDetail{}
+
+	b, err := json.Marshal(line)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+
+	assert.Contains(t, fields, "SalesItemLineDetail")
+	assert.NotContains(t, fields, "AccountBasedExpenseLineDetail")
+	assert.NotContains(t, fields, "TaxLineDetail")
+	assert.JSONEq(t, `"SalesItemLineDetail"`, string(fields["DetailType"]))
+
+	_, err = json.Marshal(Line{Amount: "1", DetailType: DiscountLineDetailType})
+	assert.Error(t, err)
+
+	_, err = json.Marshal(Line{Amount: "1"})
+	assert.Error(t, err)
+}
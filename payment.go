@@ -6,21 +6,55 @@ import (
 )
 
 type Payment struct {
-	SyncToken           string        `json:",omitempty"`
-	Domain              string        `json:"domain,omitempty"`
-	DepositToAccountRef ReferenceType `json:",omitempty"`
-	UnappliedAmt        float64       `json:",omitempty"`
-	TxnDate             Date          `json:",omitempty"`
-	TotalAmt            float64       `json:",omitempty"`
-	ProcessPayment      bool          `json:",omitempty"`
-	Line                []PaymentLine `json:",omitempty"`
-	CustomerRef         ReferenceType `json:",omitempty"`
-	Id                  string        `json:",omitempty"`
-	MetaData            MetaData      `json:",omitempty"`
+	SyncToken           string             `json:",omitempty"`
+	Domain              string             `json:"domain,omitempty"`
+	DepositToAccountRef ReferenceType      `json:",omitempty"`
+	UnappliedAmt        Decimal            `json:",omitempty"`
+	TxnDate             *Date              `json:",omitempty"`
+	TotalAmt            Decimal            `json:",omitempty"`
+	ProcessPayment      *bool              `json:",omitempty"`
+	Line                []PaymentLine      `json:",omitempty"`
+	CustomerRef         ReferenceType      `json:",omitempty"`
+	PaymentMethodRef    *ReferenceType     `json:",omitempty"`
+	PaymentRefNum       string             `json:",omitempty"`
+	CreditCardPayment   *CreditCardPayment `json:",omitempty"`
+	Id                  string             `json:",omitempty"`
+	MetaData            MetaData           `json:",omitempty"`
+}
+
+// CreditCardPayment holds the details of a payment taken by credit card.
+type CreditCardPayment struct {
+	CreditChargeInfo     *CreditChargeInfo     `json:",omitempty"`
+	CreditChargeResponse *CreditChargeResponse `json:",omitempty"`
+}
+
+// CreditChargeInfo describes the card that was charged.
+type CreditChargeInfo struct {
+	Number             string  `json:",omitempty"`
+	Type               string  `json:",omitempty"`
+	NameOnAcct         string  `json:",omitempty"`
+	CcExpiryMonth      int     `json:",omitempty"`
+	CcExpiryYear       int     `json:",omitempty"`
+	BillAddrStreet     string  `json:",omitempty"`
+	PostalCode         string  `json:",omitempty"`
+	CommercialCardCode string  `json:",omitempty"`
+	CCTxnMode          string  `json:",omitempty"`
+	CCTxnType          string  `json:",omitempty"`
+	PrevCCTransId      string  `json:",omitempty"`
+	Amount             Decimal `json:",omitempty"`
+	ProcessPayment     *bool   `json:",omitempty"`
+}
+
+// CreditChargeResponse is the card processor's response to a charge.
+type CreditChargeResponse struct {
+	Status               string    `json:",omitempty"`
+	AuthCode             string    `json:",omitempty"`
+	TxnAuthorizationTime *DateTime `json:",omitempty"`
+	CCTransId            string    `json:",omitempty"`
 }
 
 type PaymentLine struct {
-	Amount    float64     `json:",omitempty"`
+	Amount    Decimal     `json:",omitempty"`
 	LinkedTxn []LinkedTxn `json:",omitempty"`
 }
 
@@ -28,7 +62,7 @@ type PaymentLine struct {
 func (c *Client) CreatePayment(payment *Payment) (*Payment, error) {
 	var resp struct {
 		Payment Payment
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.post("payment", payment, &resp, nil); err != nil {
@@ -89,7 +123,7 @@ func (c *Client) FindPayments() ([]Payment, error) {
 func (c *Client) FindPaymentById(id string) (*Payment, error) {
 	var resp struct {
 		Payment Payment
-		Time    Date
+		Time    DateTime
 	}
 
 	if err := c.get("payment/"+id, &resp, nil); err != nil {
@@ -121,36 +155,12 @@ func (c *Client) QueryPayments(query string) ([]Payment, error) {
 }
 
 // UpdatePayment updates the given payment in QuickBooks.
-func (c *Client) UpdatePayment(payment *Payment) (*Payment, error) {
+func (c *Client) UpdatePayment(payment *Payment, opts ...UpdateOption) (*Payment, error) {
 	if payment.Id == "" {
 		return nil, errors.New("missing payment id")
 	}
 
-	existingPayment, err := c.FindPaymentById(payment.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	payment.SyncToken = existingPayment.SyncToken
-
-	payload := struct {
-		*Payment
-		Sparse bool `json:"sparse"`
-	}{
-		Payment: payment,
-		Sparse:  true,
-	}
-
-	var paymentData struct {
-		Payment Payment
-		Time    Date
-	}
-
-	if err = c.post("payment", payload, &paymentData, nil); err != nil {
-		return nil, err
-	}
-
-	return &paymentData.Payment, err
+	return update(c, "payment", "Payment", payment, c.FindPaymentById, opts)
 }
 
 // VoidPayment voids the given payment in QuickBooks.
@@ -0,0 +1,119 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+const (
+	CreditCardPaymentMethodType    = "CREDIT_CARD"
+	NonCreditCardPaymentMethodType = "NON_CREDIT_CARD"
+)
+
+// PaymentMethod represents a way customers can pay, such as cash, check or a
+// particular card brand.
+type PaymentMethod struct {
+	Id        string   `json:"Id,omitempty"`
+	SyncToken string   `json:",omitempty"`
+	MetaData  MetaData `json:",omitempty"`
+	Name      string   `json:",omitempty"`
+	Active    *bool    `json:",omitempty"`
+	Type      string   `json:",omitempty"`
+}
+
+// CreatePaymentMethod creates the given PaymentMethod on the QuickBooks server,
+// returning the resulting PaymentMethod object.
+func (c *Client) CreatePaymentMethod(paymentMethod *PaymentMethod) (*PaymentMethod, error) {
+	var resp struct {
+		PaymentMethod PaymentMethod
+		Time          DateTime
+	}
+
+	if err := c.post("paymentmethod", paymentMethod, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.PaymentMethod, nil
+}
+
+// FindPaymentMethods gets the full list of PaymentMethods in the QuickBooks account.
+func (c *Client) FindPaymentMethods() ([]PaymentMethod, error) {
+	var resp struct {
+		QueryResponse struct {
+			PaymentMethods []PaymentMethod `json:"PaymentMethod"`
+			MaxResults     int
+			StartPosition  int
+			TotalCount     int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM PaymentMethod", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no payment methods could be found")
+	}
+
+	paymentMethods := make([]PaymentMethod, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM PaymentMethod ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.PaymentMethods == nil {
+			return nil, errors.New("no payment methods could be found")
+		}
+
+		paymentMethods = append(paymentMethods, resp.QueryResponse.PaymentMethods...)
+	}
+
+	return paymentMethods, nil
+}
+
+// FindPaymentMethodById finds the payment method by the given id
+func (c *Client) FindPaymentMethodById(id string) (*PaymentMethod, error) {
+	var resp struct {
+		PaymentMethod PaymentMethod
+		Time          DateTime
+	}
+
+	if err := c.get("paymentmethod/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.PaymentMethod, nil
+}
+
+// QueryPaymentMethods accepts an SQL query and returns all payment methods found using it
+func (c *Client) QueryPaymentMethods(query string) ([]PaymentMethod, error) {
+	var resp struct {
+		QueryResponse struct {
+			PaymentMethods []PaymentMethod `json:"PaymentMethod"`
+			StartPosition  int
+			MaxResults     int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.PaymentMethods == nil {
+		return nil, errors.New("could not find any payment methods")
+	}
+
+	return resp.QueryResponse.PaymentMethods, nil
+}
+
+// UpdatePaymentMethod updates the payment method
+func (c *Client) UpdatePaymentMethod(paymentMethod *PaymentMethod, opts ...UpdateOption) (*PaymentMethod, error) {
+	if paymentMethod.Id == "" {
+		return nil, errors.New("missing payment method id")
+	}
+
+	return update(c, "paymentmethod", "PaymentMethod", paymentMethod, c.FindPaymentMethodById, opts)
+}
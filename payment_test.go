@@ -0,0 +1,54 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayment(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/payment.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Payment Payment
+		Time    DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "163", r.Payment.Id)
+	assert.Equal(t, "1", r.Payment.CustomerRef.Value)
+	assert.Equal(t, Decimal("65.0"), r.Payment.TotalAmt)
+	require.NotNil(t, r.Payment.PaymentMethodRef)
+	assert.Equal(t, "4", r.Payment.PaymentMethodRef.Value)
+	assert.Equal(t, "4519", r.Payment.PaymentRefNum)
+	require.NotNil(t, r.Payment.CreditCardPayment)
+	require.NotNil(t, r.Payment.CreditCardPayment.CreditChargeInfo)
+	assert.Equal(t, "Visa", r.Payment.CreditCardPayment.CreditChargeInfo.Type)
+	assert.Equal(t, 2018, r.Payment.CreditCardPayment.CreditChargeInfo.CcExpiryYear)
+	require.NotNil(t, r.Payment.CreditCardPayment.CreditChargeResponse)
+	assert.Equal(t, "Completed", r.Payment.CreditCardPayment.CreditChargeResponse.Status)
+	assert.Equal(t, "EFG54321", r.Payment.CreditCardPayment.CreditChargeResponse.CCTransId)
+	require.Len(t, r.Payment.Line, 1)
+	assert.Equal(t, "67", r.Payment.Line[0].LinkedTxn[0].TxnID)
+}
+
+func TestPaymentWithoutPaymentMethod(t *testing.T) {
+	b, err := json.Marshal(Payment{Id: "163", PaymentRefNum: "4520"})
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+
+	assert.NotContains(t, fields, "PaymentMethodRef")
+	assert.JSONEq(t, `"4520"`, string(fields["PaymentRefNum"]))
+}
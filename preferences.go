@@ -0,0 +1,230 @@
+package quickbooks
+
+import (
+	"sort"
+	"strings"
+)
+
+// Preferences holds the company-wide settings that affect how transactions
+// must be built, such as whether custom transaction numbers, class tracking or
+// multiple currencies are in use.
+type Preferences struct {
+	Id                      string                  `json:"Id,omitempty"`
+	SyncToken               string                  `json:",omitempty"`
+	MetaData                MetaData                `json:",omitempty"`
+	AccountingInfoPrefs     AccountingInfoPrefs     `json:",omitempty"`
+	ProductAndServicesPrefs ProductAndServicesPrefs `json:",omitempty"`
+	SalesFormsPrefs         SalesFormsPrefs         `json:",omitempty"`
+	VendorAndPurchasesPrefs VendorAndPurchasesPrefs `json:",omitempty"`
+	TimeTrackingPrefs       TimeTrackingPrefs       `json:",omitempty"`
+	TaxPrefs                TaxPrefs                `json:",omitempty"`
+	CurrencyPrefs           CurrencyPrefs           `json:",omitempty"`
+	ReportPrefs             ReportPrefs             `json:",omitempty"`
+	OtherPrefs              OtherPrefs              `json:",omitempty"`
+}
+
+type AccountingInfoPrefs struct {
+	FirstMonthOfFiscalYear  string `json:",omitempty"`
+	UseAccountNumbers       *bool  `json:",omitempty"`
+	TaxYearMonth            string `json:",omitempty"`
+	ClassTrackingPerTxn     *bool  `json:",omitempty"`
+	ClassTrackingPerTxnLine *bool  `json:",omitempty"`
+	TrackDepartments        *bool  `json:",omitempty"`
+	DepartmentTerminology   string `json:",omitempty"`
+	CustomerTerminology     string `json:",omitempty"`
+	TaxForm                 string `json:",omitempty"`
+	BookCloseDate           *Date  `json:",omitempty"`
+}
+
+type ProductAndServicesPrefs struct {
+	ForSales                 *bool `json:",omitempty"`
+	ForPurchase              *bool `json:",omitempty"`
+	QuantityWithPriceAndRate *bool `json:",omitempty"`
+	QuantityOnHand           *bool `json:",omitempty"`
+}
+
+type SalesFormsPrefs struct {
+	CustomField                []PreferencesCustomFieldGroup `json:",omitempty"`
+	CustomTxnNumbers           *bool                         `json:",omitempty"`
+	AllowDeposit               *bool                         `json:",omitempty"`
+	AllowDiscount              *bool                         `json:",omitempty"`
+	AllowEstimates             *bool                         `json:",omitempty"`
+	AllowShipping              *bool                         `json:",omitempty"`
+	AllowServiceDate           *bool                         `json:",omitempty"`
+	AutoApplyCredit            *bool                         `json:",omitempty"`
+	AutoApplyPayments          *bool                         `json:",omitempty"`
+	UsingPriceLevels           *bool                         `json:",omitempty"`
+	UsingProgressInvoicing     *bool                         `json:",omitempty"`
+	IPNSupportEnabled          *bool                         `json:",omitempty"`
+	EmailCopyToCompany         *bool                         `json:",omitempty"`
+	ETransactionEnabledStatus  string                        `json:",omitempty"`
+	ETransactionPaymentEnabled *bool                         `json:",omitempty"`
+	ETransactionAttachPDF      *bool                         `json:",omitempty"`
+	DefaultTerms               *ReferenceType                `json:",omitempty"`
+	DefaultDiscountAccount     string                        `json:",omitempty"`
+	DefaultCustomerMessage     string                        `json:",omitempty"`
+	EstimateMessage            string                        `json:",omitempty"`
+}
+
+// PreferencesCustomFieldGroup is a group of custom field settings, as found in
+// the sales form and purchase order preferences.
+type PreferencesCustomFieldGroup struct {
+	CustomField []PreferencesCustomField `json:",omitempty"`
+}
+
+// PreferencesCustomField is a single custom field setting. Either StringValue
+// or BooleanValue is set, depending on Type.
+type PreferencesCustomField struct {
+	Name         string `json:",omitempty"`
+	Type         string `json:",omitempty"`
+	StringValue  string `json:",omitempty"`
+	BooleanValue *bool  `json:",omitempty"`
+}
+
+// CustomFieldDefinition describes one of the custom fields available on sales
+// forms. DefinitionId matches CustomField.DefinitionId on transactions.
+type CustomFieldDefinition struct {
+	DefinitionId string
+	Name         string
+	Enabled      bool
+}
+
+type VendorAndPurchasesPrefs struct {
+	BillableExpenseTracking *bool                         `json:",omitempty"`
+	TrackingByCustomer      *bool                         `json:",omitempty"`
+	TPAREnabled             *bool                         `json:",omitempty"`
+	DefaultTerms            *ReferenceType                `json:",omitempty"`
+	DefaultMarkup           Decimal                       `json:",omitempty"`
+	DefaultMarkupAccount    *ReferenceType                `json:",omitempty"`
+	POCustomField           []PreferencesCustomFieldGroup `json:",omitempty"`
+}
+
+type TimeTrackingPrefs struct {
+	WorkWeekStartDate       string `json:",omitempty"`
+	UseServices             *bool  `json:",omitempty"`
+	BillCustomers           *bool  `json:",omitempty"`
+	ShowBillRateToAll       *bool  `json:",omitempty"`
+	MarkTimeEntriesBillable *bool  `json:",omitempty"`
+}
+
+type TaxPrefs struct {
+	UsingSalesTax     *bool          `json:",omitempty"`
+	PartnerTaxEnabled *bool          `json:",omitempty"`
+	TaxGroupCodeRef   *ReferenceType `json:",omitempty"`
+}
+
+type CurrencyPrefs struct {
+	MultiCurrencyEnabled *bool          `json:",omitempty"`
+	HomeCurrency         *ReferenceType `json:",omitempty"`
+}
+
+type ReportPrefs struct {
+	ReportBasis                string `json:",omitempty"`
+	CalcAgingReportFromTxnDate *bool  `json:",omitempty"`
+}
+
+// OtherPrefs holds the preferences that have no dedicated field, as name/value
+// pairs.
+type OtherPrefs struct {
+	NameValue []NameValue `json:",omitempty"`
+}
+
+// NameValue is a generic name/value pair.
+type NameValue struct {
+	Name  string `json:",omitempty"`
+	Value string `json:",omitempty"`
+}
+
+// CustomTxnNumbersEnabled reports whether transactions take their DocNumber
+// from the caller rather than being numbered by QuickBooks.
+func (p *Preferences) CustomTxnNumbersEnabled() bool {
+	return boolValue(p.SalesFormsPrefs.CustomTxnNumbers)
+}
+
+// MultiCurrencyEnabled reports whether transactions may be in a currency other
+// than the home currency.
+func (p *Preferences) MultiCurrencyEnabled() bool {
+	return boolValue(p.CurrencyPrefs.MultiCurrencyEnabled)
+}
+
+// ClassTrackingPerLine reports whether classes are assigned to each line
+// rather than to the transaction as a whole.
+func (p *Preferences) ClassTrackingPerLine() bool {
+	return boolValue(p.AccountingInfoPrefs.ClassTrackingPerTxnLine)
+}
+
+// AutomatedSalesTaxEnabled reports whether QuickBooks calculates sales tax
+// itself, in which case transactions refer to the "TAX" and "NON" tax codes.
+func (p *Preferences) AutomatedSalesTaxEnabled() bool {
+	return boolValue(p.TaxPrefs.PartnerTaxEnabled)
+}
+
+// CustomFieldDefinitions returns the custom fields defined for sales forms,
+// ordered by DefinitionId.
+func (p *SalesFormsPrefs) CustomFieldDefinitions() []CustomFieldDefinition {
+	definitions := make(map[string]*CustomFieldDefinition)
+
+	definition := func(id string) *CustomFieldDefinition {
+		if _, ok := definitions[id]; !ok {
+			definitions[id] = &CustomFieldDefinition{DefinitionId: id}
+		}
+
+		return definitions[id]
+	}
+
+	for _, group := range p.CustomField {
+		for _, customField := range group.CustomField {
+			name := strings.TrimPrefix(customField.Name, "SalesFormsPrefs.")
+
+			switch {
+			case strings.HasPrefix(name, "UseSalesCustom"):
+				definition(strings.TrimPrefix(name, "UseSalesCustom")).Enabled = boolValue(customField.BooleanValue)
+			case strings.HasPrefix(name, "SalesCustomName"):
+				definition(strings.TrimPrefix(name, "SalesCustomName")).Name = customField.StringValue
+			}
+		}
+	}
+
+	result := make([]CustomFieldDefinition, 0, len(definitions))
+	for _, definition := range definitions {
+		result = append(result, *definition)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].DefinitionId < result[j].DefinitionId
+	})
+
+	return result
+}
+
+// Value returns the value of the named preference, and whether it was found.
+func (p *OtherPrefs) Value(name string) (string, bool) {
+	for _, nameValue := range p.NameValue {
+		if nameValue.Name == name {
+			return nameValue.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// FindPreferences returns the QuickBooks Preferences object.
+func (c *Client) FindPreferences() (*Preferences, error) {
+	var resp struct {
+		Preferences Preferences
+		Time        DateTime
+	}
+
+	if err := c.get("preferences", &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Preferences, nil
+}
+
+// UpdatePreferences updates the preferences
+func (c *Client) UpdatePreferences(preferences *Preferences, opts ...UpdateOption) (*Preferences, error) {
+	return update(c, "preferences", "Preferences", preferences, func(string) (*Preferences, error) {
+		return c.FindPreferences()
+	}, opts)
+}
@@ -0,0 +1,70 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferences(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/preferences.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Preferences Preferences
+		Time        DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	p := r.Preferences
+	assert.Equal(t, "1", p.Id)
+	assert.Equal(t, "6", p.SyncToken)
+	assert.True(t, p.CustomTxnNumbersEnabled())
+	assert.False(t, p.MultiCurrencyEnabled())
+	assert.True(t, p.ClassTrackingPerLine())
+	assert.True(t, p.AutomatedSalesTaxEnabled())
+	assert.Equal(t, "Location", p.AccountingInfoPrefs.DepartmentTerminology)
+	assert.Equal(t, "2014-09-30", p.AccountingInfoPrefs.BookCloseDate.Format("2006-01-02"))
+	assert.Equal(t, "3", p.SalesFormsPrefs.DefaultTerms.Value)
+	assert.Equal(t, Decimal("10"), p.VendorAndPurchasesPrefs.DefaultMarkup)
+	assert.Equal(t, "USD", p.CurrencyPrefs.HomeCurrency.Value)
+	assert.Equal(t, "Monday", p.TimeTrackingPrefs.WorkWeekStartDate)
+
+	assert.Equal(t, []CustomFieldDefinition{
+		{DefinitionId: "1", Name: "Crew #", Enabled: true},
+		{DefinitionId: "2", Name: "Sales Rep", Enabled: false},
+	}, p.SalesFormsPrefs.CustomFieldDefinitions())
+
+	value, ok := p.OtherPrefs.Value("UncategorizedAssetAccountId")
+	assert.True(t, ok)
+	assert.Equal(t, "32", value)
+	_, ok = p.OtherPrefs.Value("Missing")
+	assert.False(t, ok)
+}
+
+func TestPreferencesSparse(t *testing.T) {
+	preferences := Preferences{Id: "1"}
+	preferences.SalesFormsPrefs.CustomTxnNumbers = Bool(false)
+
+	b, err := json.Marshal(preferences)
+	require.NoError(t, err)
+
+	var fields struct {
+		SalesFormsPrefs map[string]json.RawMessage
+		TaxPrefs        map[string]json.RawMessage
+	}
+	require.NoError(t, json.Unmarshal(b, &fields))
+
+	assert.Len(t, fields.SalesFormsPrefs, 1)
+	assert.JSONEq(t, `false`, string(fields.SalesFormsPrefs["CustomTxnNumbers"]))
+	assert.Empty(t, fields.TaxPrefs)
+}
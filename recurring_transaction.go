@@ -0,0 +1,400 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+const (
+	AutomatedRecurType   = "Automated"
+	RemindedRecurType    = "Reminded"
+	UnscheduledRecurType = "Unscheduled"
+)
+
+// RecurringTransaction is a template from which QuickBooks creates
+// transactions on a schedule. Exactly one of the transaction fields is set,
+// and that transaction's RecurringInfo describes the schedule.
+type RecurringTransaction struct {
+	Invoice      *Invoice      `json:",omitempty"`
+	Bill         *Bill         `json:",omitempty"`
+	Estimate     *Estimate     `json:",omitempty"`
+	CreditMemo   *CreditMemo   `json:",omitempty"`
+	Deposit      *Deposit      `json:",omitempty"`
+	VendorCredit *VendorCredit `json:",omitempty"`
+	Transfer     *Transfer     `json:",omitempty"`
+	// OtherType and Other hold a template for a transaction type this package
+	// doesn't model, such as JournalEntry, Purchase or SalesReceipt: its type
+	// and its JSON, which is sent back as it is.
+	OtherType string          `json:"-"`
+	Other     json.RawMessage `json:"-"`
+}
+
+// recurringTransactionFields are the JSON keys of the transactions modelled
+// by RecurringTransaction.
+var recurringTransactionFields = map[string]bool{
+	"Invoice":      true,
+	"Bill":         true,
+	"Estimate":     true,
+	"CreditMemo":   true,
+	"Deposit":      true,
+	"VendorCredit": true,
+	"Transfer":     true,
+}
+
+// MarshalJSON writes the recurring transaction, including a transaction of
+// another type held in Other.
+func (r RecurringTransaction) MarshalJSON() ([]byte, error) {
+	type recurringTransaction RecurringTransaction
+
+	b, err := json.Marshal(recurringTransaction(r))
+	if err != nil || r.OtherType == "" {
+		return b, err
+	}
+
+	if recurringTransactionFields[r.OtherType] {
+		return nil, errors.New("use the " + r.OtherType + " field for a recurring " + r.OtherType)
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	fields[r.OtherType] = r.Other
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON reads a recurring transaction, keeping a transaction of a
+// type that isn't modelled in OtherType and Other.
+func (r *RecurringTransaction) UnmarshalJSON(b []byte) error {
+	type recurringTransaction RecurringTransaction
+
+	var in recurringTransaction
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	for key, value := range fields {
+		if recurringTransactionFields[key] || len(value) == 0 || value[0] != '{' {
+			continue
+		}
+
+		if in.OtherType != "" {
+			return errors.New("recurring transaction wraps both " + in.OtherType + " and " + key)
+		}
+
+		in.OtherType, in.Other = key, value
+	}
+
+	*r = RecurringTransaction(in)
+
+	return nil
+}
+
+// RecurringInfo describes how a recurring transaction template is scheduled.
+type RecurringInfo struct {
+	Name         string                 `json:",omitempty"`
+	RecurType    string                 `json:",omitempty"`
+	Active       *bool                  `json:",omitempty"`
+	ScheduleInfo *RecurringScheduleInfo `json:",omitempty"`
+}
+
+// RecurringScheduleInfo is the schedule of a recurring transaction. Unset
+// dates are left nil.
+type RecurringScheduleInfo struct {
+	IntervalType   string `json:",omitempty"`
+	NumInterval    int    `json:",omitempty"`
+	DayOfMonth     int    `json:",omitempty"`
+	DayOfWeek      string `json:",omitempty"`
+	WeekOfMonth    int    `json:",omitempty"`
+	MonthOfYear    string `json:",omitempty"`
+	DaysBefore     int    `json:",omitempty"`
+	RemindDays     int    `json:",omitempty"`
+	MaxOccurrences int    `json:",omitempty"`
+	StartDate      *Date  `json:",omitempty"`
+	NextDate       *Date  `json:",omitempty"`
+	PreviousDate   *Date  `json:",omitempty"`
+	EndDate        *Date  `json:",omitempty"`
+}
+
+// modelled returns pointers to the Id and SyncToken of each wrapped
+// transaction of a type this package models.
+func (r *RecurringTransaction) modelled() (ids []*string, syncTokens []*string) {
+	if r.Invoice != nil {
+		ids, syncTokens = append(ids, &r.Invoice.Id), append(syncTokens, &r.Invoice.SyncToken)
+	}
+	if r.Bill != nil {
+		ids, syncTokens = append(ids, &r.Bill.Id), append(syncTokens, &r.Bill.SyncToken)
+	}
+	if r.Estimate != nil {
+		ids, syncTokens = append(ids, &r.Estimate.Id), append(syncTokens, &r.Estimate.SyncToken)
+	}
+	if r.CreditMemo != nil {
+		ids, syncTokens = append(ids, &r.CreditMemo.Id), append(syncTokens, &r.CreditMemo.SyncToken)
+	}
+	if r.Deposit != nil {
+		ids, syncTokens = append(ids, &r.Deposit.Id), append(syncTokens, &r.Deposit.SyncToken)
+	}
+	if r.VendorCredit != nil {
+		ids, syncTokens = append(ids, &r.VendorCredit.Id), append(syncTokens, &r.VendorCredit.SyncToken)
+	}
+	if r.Transfer != nil {
+		ids, syncTokens = append(ids, &r.Transfer.Id), append(syncTokens, &r.Transfer.SyncToken)
+	}
+
+	return ids, syncTokens
+}
+
+// identity returns the Id and SyncToken of the wrapped transaction.
+func (r *RecurringTransaction) identity() (id string, syncToken string, err error) {
+	ids, syncTokens := r.modelled()
+
+	if r.OtherType != "" {
+		var other struct {
+			Id        string
+			SyncToken string
+		}
+
+		if err := json.Unmarshal(r.Other, &other); err != nil {
+			return "", "", err
+		}
+
+		ids, syncTokens = append(ids, &other.Id), append(syncTokens, &other.SyncToken)
+	}
+
+	if len(ids) != 1 {
+		return "", "", errors.New("recurring transaction must wrap exactly one transaction")
+	}
+
+	return *ids[0], *syncTokens[0], nil
+}
+
+// fetched reports whether the wrapped transaction was read from QuickBooks.
+// Transactions of a type this package doesn't model are kept whole, so they
+// always count as fetched.
+func (r *RecurringTransaction) fetched() bool {
+	if r.OtherType != "" {
+		return true
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	for i := 0; i < fields.NumField(); i++ {
+		field := fields.Field(i)
+		if field.Kind() == reflect.Ptr && !field.IsNil() {
+			return fetched(field.Elem())
+		}
+	}
+
+	return false
+}
+
+// setSyncToken sets the SyncToken of the wrapped transaction.
+func (r *RecurringTransaction) setSyncToken(syncToken string) error {
+	if _, _, err := r.identity(); err != nil {
+		return err
+	}
+
+	if _, syncTokens := r.modelled(); len(syncTokens) == 1 {
+		*syncTokens[0] = syncToken
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(r.Other, &fields); err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(syncToken)
+	if err != nil {
+		return err
+	}
+
+	fields["SyncToken"] = value
+
+	r.Other, err = json.Marshal(fields)
+
+	return err
+}
+
+// CreateRecurringTransaction creates the given RecurringTransaction on the
+// QuickBooks server, returning the resulting RecurringTransaction object.
+func (c *Client) CreateRecurringTransaction(recurringTransaction *RecurringTransaction) (*RecurringTransaction, error) {
+	if _, _, err := recurringTransaction.identity(); err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		RecurringTransaction RecurringTransaction
+		Time                 DateTime
+	}
+
+	if err := c.post("recurringtransaction", recurringTransaction, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.RecurringTransaction, nil
+}
+
+// DeleteRecurringTransaction deletes the recurring transaction
+func (c *Client) DeleteRecurringTransaction(recurringTransaction *RecurringTransaction) error {
+	id, syncToken, err := recurringTransaction.identity()
+	if err != nil {
+		return err
+	}
+
+	if id == "" || syncToken == "" {
+		return errors.New("missing id/sync token")
+	}
+
+	return c.post("recurringtransaction", recurringTransaction, nil, map[string]string{"operation": "delete"})
+}
+
+// FindRecurringTransactions gets the full list of RecurringTransactions in the QuickBooks account.
+func (c *Client) FindRecurringTransactions() ([]RecurringTransaction, error) {
+	var resp struct {
+		QueryResponse struct {
+			RecurringTransactions []RecurringTransaction `json:"RecurringTransaction"`
+			MaxResults            int
+			StartPosition         int
+			TotalCount            int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM RecurringTransaction", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no recurring transactions could be found")
+	}
+
+	recurringTransactions := make([]RecurringTransaction, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM RecurringTransaction ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.RecurringTransactions == nil {
+			return nil, errors.New("no recurring transactions could be found")
+		}
+
+		recurringTransactions = append(recurringTransactions, resp.QueryResponse.RecurringTransactions...)
+	}
+
+	return recurringTransactions, nil
+}
+
+// FindRecurringTransactionById finds the recurring transaction by the given id
+func (c *Client) FindRecurringTransactionById(id string) (*RecurringTransaction, error) {
+	var resp struct {
+		RecurringTransaction RecurringTransaction
+		Time                 DateTime
+	}
+
+	if err := c.get("recurringtransaction/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.RecurringTransaction, nil
+}
+
+// QueryRecurringTransactions accepts an SQL query and returns all recurring transactions found using it
+func (c *Client) QueryRecurringTransactions(query string) ([]RecurringTransaction, error) {
+	var resp struct {
+		QueryResponse struct {
+			RecurringTransactions []RecurringTransaction `json:"RecurringTransaction"`
+			StartPosition         int
+			MaxResults            int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.RecurringTransactions == nil {
+		return nil, errors.New("could not find any recurring transactions")
+	}
+
+	return resp.QueryResponse.RecurringTransactions, nil
+}
+
+// UpdateRecurringTransaction updates the recurring transaction. QuickBooks
+// doesn't support sparse updates of recurring transactions, so every update
+// replaces the whole template as with FullUpdate, which may be given but
+// changes nothing: the template must have been fetched, and fields this
+// package doesn't model are copied from the current version of it.
+func (c *Client) UpdateRecurringTransaction(recurringTransaction *RecurringTransaction, opts ...UpdateOption) (*RecurringTransaction, error) {
+	id, _, err := recurringTransaction.identity()
+	if err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, errors.New("missing recurring transaction id")
+	}
+
+	if !recurringTransaction.fetched() {
+		return nil, errors.New("a recurring transaction update needs a template fetched from QuickBooks")
+	}
+
+	var currentData map[string]json.RawMessage
+	if err = c.get("recurringtransaction/"+id, &currentData, nil); err != nil {
+		return nil, err
+	}
+
+	current := currentData["RecurringTransaction"]
+	if current == nil {
+		return nil, errors.New("could not read the current RecurringTransaction")
+	}
+
+	if !newUpdateOptions(opts).keepSyncToken {
+		var existingRecurringTransaction RecurringTransaction
+		if err = json.Unmarshal(current, &existingRecurringTransaction); err != nil {
+			return nil, err
+		}
+
+		_, existingSyncToken, err := existingRecurringTransaction.identity()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = recurringTransaction.setSyncToken(existingSyncToken); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := json.Marshal(recurringTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := keepUnmodelled(reflect.TypeOf(*recurringTransaction), b, current)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurringTransactionData struct {
+		RecurringTransaction RecurringTransaction
+		Time                 DateTime
+	}
+
+	if err = c.post("recurringtransaction", payload, &recurringTransactionData, nil); err != nil {
+		return nil, conflictError(err, func() (interface{}, error) {
+			return c.FindRecurringTransactionById(id)
+		})
+	}
+
+	return &recurringTransactionData.RecurringTransaction, err
+}
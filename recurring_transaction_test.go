@@ -0,0 +1,160 @@
+package quickbooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringTransaction(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/recurring_transaction.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		RecurringTransaction RecurringTransaction
+		Time                 DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Nil(t, r.RecurringTransaction.Bill)
+	require.NotNil(t, r.RecurringTransaction.Invoice)
+
+	invoice := r.RecurringTransaction.Invoice
+	assert.Equal(t, "106", invoice.Id)
+	assert.Equal(t, "58", invoice.CustomerRef.Value)
+
+	require.NotNil(t, invoice.RecurringInfo)
+	assert.Equal(t, "Monthly retainer", invoice.RecurringInfo.Name)
+	assert.Equal(t, AutomatedRecurType, invoice.RecurringInfo.RecurType)
+	assert.Equal(t, Bool(true), invoice.RecurringInfo.Active)
+
+	schedule := invoice.RecurringInfo.ScheduleInfo
+	require.NotNil(t, schedule)
+	assert.Equal(t, "Monthly", schedule.IntervalType)
+	assert.Equal(t, 1, schedule.NumInterval)
+	assert.Equal(t, 1, schedule.DayOfMonth)
+	assert.Equal(t, 12, schedule.MaxOccurrences)
+	require.NotNil(t, schedule.NextDate)
+	assert.Equal(t, "2023-04-01", schedule.NextDate.Format(secondFormat))
+	require.NotNil(t, schedule.EndDate)
+	assert.Equal(t, "2024-01-01", schedule.EndDate.Format(secondFormat))
+
+	id, syncToken, err := r.RecurringTransaction.identity()
+	require.NoError(t, err)
+	assert.Equal(t, "106", id)
+	assert.Equal(t, "2", syncToken)
+
+	_, _, err = (&RecurringTransaction{}).identity()
+	assert.Error(t, err)
+}
+
+func TestUpdateRecurringTransactionKeepsUnmodelledFields(t *testing.T) {
+	byteValue, err := ioutil.ReadFile("data/testing/recurring_transaction.json")
+	require.NoError(t, err)
+
+	var posts []map[string]json.RawMessage
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			var fields map[string]json.RawMessage
+			json.Unmarshal(body, &fields)
+			posts = append(posts, fields)
+		}
+		w.Write(bytes.Replace(byteValue, []byte(`"SyncToken": "2"`), []byte(`"SyncToken": "5"`), 1))
+	}))
+
+	recurringTransaction, err := client.FindRecurringTransactionById("106")
+	require.NoError(t, err)
+
+	recurringTransaction.Invoice.RecurringInfo.Name = "Quarterly retainer"
+
+	_, err = client.UpdateRecurringTransaction(recurringTransaction)
+	require.NoError(t, err)
+
+	_, err = client.UpdateRecurringTransaction(recurringTransaction, KeepSyncToken(), FullUpdate())
+	require.NoError(t, err)
+
+	require.Len(t, posts, 2)
+	for _, post := range posts {
+		var invoice map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(post["Invoice"], &invoice))
+
+		assert.JSONEq(t, `"TaxExcluded"`, string(invoice["GlobalTaxCalculation"]))
+		assert.JSONEq(t, `"5"`, string(invoice["SyncToken"]))
+		assert.Contains(t, string(invoice["RecurringInfo"]), "Quarterly retainer")
+		assert.NotContains(t, post, "sparse")
+	}
+
+	// Anything left unset would be cleared, so the template must be fetched.
+	_, err = client.UpdateRecurringTransaction(&RecurringTransaction{Invoice: &Invoice{Id: "106"}})
+	assert.Error(t, err)
+	assert.Len(t, posts, 2)
+}
+
+func TestRecurringTransactionOtherType(t *testing.T) {
+	byteValue, err := ioutil.ReadFile("data/testing/recurring_journal_entry.json")
+	require.NoError(t, err)
+
+	var r struct {
+		RecurringTransaction RecurringTransaction
+		Time                 DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	recurringTransaction := r.RecurringTransaction
+	assert.Nil(t, recurringTransaction.Invoice)
+	assert.Equal(t, "JournalEntry", recurringTransaction.OtherType)
+
+	id, syncToken, err := recurringTransaction.identity()
+	require.NoError(t, err)
+	assert.Equal(t, "112", id)
+	assert.Equal(t, "1", syncToken)
+
+	// The journal entry is sent back as it was received.
+	var fixture struct {
+		RecurringTransaction json.RawMessage
+	}
+	require.NoError(t, json.Unmarshal(byteValue, &fixture))
+
+	b, err := json.Marshal(recurringTransaction)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(fixture.RecurringTransaction), string(b))
+
+	// Only the SyncToken changes when it is refreshed for an update.
+	posted := make(chan []byte, 1)
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write(bytes.Replace(byteValue, []byte(`"SyncToken": "1"`), []byte(`"SyncToken": "4"`), 1))
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		posted <- body
+		w.Write([]byte(`{"RecurringTransaction": ` + string(body) + `}`))
+	}))
+
+	updated, err := client.UpdateRecurringTransaction(&recurringTransaction)
+	require.NoError(t, err)
+	assert.Equal(t, "JournalEntry", updated.OtherType)
+
+	expected := bytes.Replace(fixture.RecurringTransaction, []byte(`"SyncToken": "1"`), []byte(`"SyncToken": "4"`), 1)
+	assert.JSONEq(t, string(expected), string(<-posted))
+
+	_, err = json.Marshal(RecurringTransaction{OtherType: "Invoice", Other: json.RawMessage(`{}`)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+// TaxAgency represents a government body that tax is collected for.
+type TaxAgency struct {
+	Id                    string   `json:"Id,omitempty"`
+	SyncToken             string   `json:",omitempty"`
+	MetaData              MetaData `json:",omitempty"`
+	DisplayName           string   `json:",omitempty"`
+	TaxRegistrationNumber string   `json:",omitempty"`
+	TaxTrackedOnSales     bool     `json:",omitempty"`
+	TaxTrackedOnPurchases bool     `json:",omitempty"`
+}
+
+// CreateTaxAgency creates the given TaxAgency on the QuickBooks server,
+// returning the resulting TaxAgency object.
+func (c *Client) CreateTaxAgency(taxAgency *TaxAgency) (*TaxAgency, error) {
+	var resp struct {
+		TaxAgency TaxAgency
+		Time      DateTime
+	}
+
+	if err := c.post("taxagency", taxAgency, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.TaxAgency, nil
+}
+
+// FindTaxAgencies gets the full list of TaxAgencies in the QuickBooks account.
+func (c *Client) FindTaxAgencies() ([]TaxAgency, error) {
+	var resp struct {
+		QueryResponse struct {
+			TaxAgencies   []TaxAgency `json:"TaxAgency"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM TaxAgency", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no tax agencies could be found")
+	}
+
+	taxAgencies := make([]TaxAgency, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM TaxAgency ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.TaxAgencies == nil {
+			return nil, errors.New("no tax agencies could be found")
+		}
+
+		taxAgencies = append(taxAgencies, resp.QueryResponse.TaxAgencies...)
+	}
+
+	return taxAgencies, nil
+}
+
+// FindTaxAgencyById finds the tax agency by the given id
+func (c *Client) FindTaxAgencyById(id string) (*TaxAgency, error) {
+	var resp struct {
+		TaxAgency TaxAgency
+		Time      DateTime
+	}
+
+	if err := c.get("taxagency/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.TaxAgency, nil
+}
+
+// QueryTaxAgencies accepts an SQL query and returns all tax agencies found using it
+func (c *Client) QueryTaxAgencies(query string) ([]TaxAgency, error) {
+	var resp struct {
+		QueryResponse struct {
+			TaxAgencies   []TaxAgency `json:"TaxAgency"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TaxAgencies == nil {
+		return nil, errors.New("could not find any tax agencies")
+	}
+
+	return resp.QueryResponse.TaxAgencies, nil
+}
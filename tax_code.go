@@ -0,0 +1,171 @@
+package quickbooks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// TaxCode represents a QuickBooks tax code, which groups the tax rates
+// applied to sales and purchases.
+type TaxCode struct {
+	Id                  string      `json:"Id,omitempty"`
+	SyncToken           string      `json:",omitempty"`
+	MetaData            MetaData    `json:",omitempty"`
+	Name                string      `json:",omitempty"`
+	Description         string      `json:",omitempty"`
+	Active              bool        `json:",omitempty"`
+	Hidden              bool        `json:",omitempty"`
+	Taxable             bool        `json:",omitempty"`
+	TaxGroup            bool        `json:",omitempty"`
+	SalesTaxRateList    TaxRateList `json:",omitempty"`
+	PurchaseTaxRateList TaxRateList `json:",omitempty"`
+}
+
+// TaxRateList is the list of tax rates a tax code applies.
+type TaxRateList struct {
+	TaxRateDetail []TaxRateDetail `json:",omitempty"`
+}
+
+// TaxRateDetail refers to one of the tax rates in a tax code.
+type TaxRateDetail struct {
+	TaxRateRef        ReferenceType `json:",omitempty"`
+	TaxTypeApplicable string        `json:",omitempty"`
+	TaxOrder          int           `json:",omitempty"`
+	TaxOnTaxOrder     int           `json:",omitempty"`
+}
+
+// TaxRateComponent is one of the rates making up a tax code, with the rate
+// value in effect on a given date.
+type TaxRateComponent struct {
+	TaxRate           TaxRate
+	RateValue         Decimal
+	TaxTypeApplicable string
+	TaxOrder          int
+}
+
+// ResolveTaxCode expands the tax code referred to by taxCodeRef into the
+// sales and purchase tax rates it is made of, with the rate values in effect
+// on the given date.
+//
+// US companies using automated sales tax refer to the pseudo tax codes "TAX"
+// and "NON", which can't be resolved.
+func (c *Client) ResolveTaxCode(taxCodeRef ReferenceType, date Date) (sales []TaxRateComponent, purchase []TaxRateComponent, err error) {
+	taxCode, err := c.FindTaxCodeById(taxCodeRef.Value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find tax code %s: %v", taxCodeRef.Value, err)
+	}
+
+	taxRates := make(map[string]*TaxRate)
+
+	resolve := func(taxRateList TaxRateList) ([]TaxRateComponent, error) {
+		var components []TaxRateComponent
+
+		for _, taxRateDetail := range taxRateList.TaxRateDetail {
+			id := taxRateDetail.TaxRateRef.Value
+
+			taxRate, ok := taxRates[id]
+			if !ok {
+				found, err := c.FindTaxRateById(id)
+				if err != nil {
+					return nil, fmt.Errorf("failed to find tax rate %s: %v", id, err)
+				}
+
+				taxRate = found
+				taxRates[id] = found
+			}
+
+			components = append(components, TaxRateComponent{
+				TaxRate:           *taxRate,
+				RateValue:         taxRate.RateOn(date),
+				TaxTypeApplicable: taxRateDetail.TaxTypeApplicable,
+				TaxOrder:          taxRateDetail.TaxOrder,
+			})
+		}
+
+		return components, nil
+	}
+
+	if sales, err = resolve(taxCode.SalesTaxRateList); err != nil {
+		return nil, nil, err
+	}
+
+	if purchase, err = resolve(taxCode.PurchaseTaxRateList); err != nil {
+		return nil, nil, err
+	}
+
+	return sales, purchase, nil
+}
+
+// FindTaxCodes gets the full list of TaxCodes in the QuickBooks account.
+func (c *Client) FindTaxCodes() ([]TaxCode, error) {
+	var resp struct {
+		QueryResponse struct {
+			TaxCodes      []TaxCode `json:"TaxCode"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM TaxCode", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no tax codes could be found")
+	}
+
+	taxCodes := make([]TaxCode, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM TaxCode ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.TaxCodes == nil {
+			return nil, errors.New("no tax codes could be found")
+		}
+
+		taxCodes = append(taxCodes, resp.QueryResponse.TaxCodes...)
+	}
+
+	return taxCodes, nil
+}
+
+// FindTaxCodeById finds the tax code by the given id
+func (c *Client) FindTaxCodeById(id string) (*TaxCode, error) {
+	var resp struct {
+		TaxCode TaxCode
+		Time    DateTime
+	}
+
+	if err := c.get("taxcode/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.TaxCode, nil
+}
+
+// QueryTaxCodes accepts an SQL query and returns all tax codes found using it
+func (c *Client) QueryTaxCodes(query string) ([]TaxCode, error) {
+	var resp struct {
+		QueryResponse struct {
+			TaxCodes      []TaxCode `json:"TaxCode"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TaxCodes == nil {
+		return nil, errors.New("could not find any tax codes")
+	}
+
+	return resp.QueryResponse.TaxCodes, nil
+}
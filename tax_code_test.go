@@ -0,0 +1,130 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// taxServer is a fake QuickBooks company holding the given tax codes and tax
+// rates, which counts how often each tax rate is read.
+type taxServer struct {
+	taxCodes  map[string]TaxCode
+	taxRates  map[string]TaxRate
+	rateReads map[string]int
+}
+
+func (s *taxServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	notFound := func() {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"Fault": {"Error": [{"Message": "Object Not Found", "code": "610"}], "type": "ValidationFault"}}`))
+	}
+
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/taxcode/"):
+		taxCode, ok := s.taxCodes[strings.TrimPrefix(r.URL.Path, "/taxcode/")]
+		if !ok {
+			notFound()
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"TaxCode": taxCode})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/taxrate/"):
+		id := strings.TrimPrefix(r.URL.Path, "/taxrate/")
+		s.rateReads[id]++
+
+		taxRate, ok := s.taxRates[id]
+		if !ok {
+			notFound()
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"TaxRate": taxRate})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func taxRateList(ids ...string) TaxRateList {
+	var list TaxRateList
+	for i, id := range ids {
+		list.TaxRateDetail = append(list.TaxRateDetail, TaxRateDetail{
+			TaxRateRef:        ReferenceType{Value: id},
+			TaxTypeApplicable: "TaxOnAmount",
+			TaxOrder:          i,
+		})
+	}
+
+	return list
+}
+
+func newTaxServer() *taxServer {
+	return &taxServer{
+		taxCodes: map[string]TaxCode{
+			"5": {
+				Id:                  "5",
+				Name:                "Standard",
+				SalesTaxRateList:    taxRateList("2", "3"),
+				PurchaseTaxRateList: taxRateList("2", "4"),
+			},
+			"6": {
+				Id:               "6",
+				Name:             "Broken",
+				SalesTaxRateList: taxRateList("2", "99"),
+			},
+		},
+		taxRates: map[string]TaxRate{
+			"2": {Id: "2", Name: "California", RateValue: "8", EffectiveTaxRate: []EffectiveTaxRate{
+				{RateValue: "7.5", EffectiveDate: Date{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)}, EndDate: &Date{time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC)}},
+				{RateValue: "8", EffectiveDate: Date{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)}},
+			}},
+			"3": {Id: "3", Name: "City", RateValue: "1.5"},
+			"4": {Id: "4", Name: "Use Tax", RateValue: "6"},
+		},
+		rateReads: make(map[string]int),
+	}
+}
+
+func TestResolveTaxCode(t *testing.T) {
+	server := newTaxServer()
+	client := newTestClient(t, server)
+
+	sales, purchase, err := client.ResolveTaxCode(ReferenceType{Value: "5"}, Date{time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+
+	require.Len(t, sales, 2)
+	assert.Equal(t, "2", sales[0].TaxRate.Id)
+	assert.Equal(t, Decimal("7.5"), sales[0].RateValue)
+	assert.Equal(t, "TaxOnAmount", sales[0].TaxTypeApplicable)
+	assert.Equal(t, 0, sales[0].TaxOrder)
+	assert.Equal(t, "3", sales[1].TaxRate.Id)
+	assert.Equal(t, Decimal("1.5"), sales[1].RateValue)
+	assert.Equal(t, 1, sales[1].TaxOrder)
+
+	require.Len(t, purchase, 2)
+	assert.Equal(t, "2", purchase[0].TaxRate.Id)
+	assert.Equal(t, Decimal("7.5"), purchase[0].RateValue)
+	assert.Equal(t, "4", purchase[1].TaxRate.Id)
+	assert.Equal(t, Decimal("6"), purchase[1].RateValue)
+
+	// The rate in both lists is only read once.
+	assert.Equal(t, map[string]int{"2": 1, "3": 1, "4": 1}, server.rateReads)
+}
+
+func TestResolveTaxCodeFailures(t *testing.T) {
+	server := newTaxServer()
+	client := newTestClient(t, server)
+
+	_, _, err := client.ResolveTaxCode(ReferenceType{Value: "6"}, Date{time.Now()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tax rate 99")
+
+	_, _, err = client.ResolveTaxCode(ReferenceType{Value: "TAX"}, Date{time.Now()})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tax code TAX")
+}
@@ -0,0 +1,131 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+// TaxRate represents a single rate of tax collected for a tax agency. Tax
+// rates are combined into tax codes.
+type TaxRate struct {
+	Id               string             `json:"Id,omitempty"`
+	SyncToken        string             `json:",omitempty"`
+	MetaData         MetaData           `json:",omitempty"`
+	Name             string             `json:",omitempty"`
+	Description      string             `json:",omitempty"`
+	Active           bool               `json:",omitempty"`
+	RateValue        Decimal            `json:",omitempty"`
+	AgencyRef        ReferenceType      `json:",omitempty"`
+	TaxReturnLineRef ReferenceType      `json:",omitempty"`
+	SpecialTaxType   string             `json:",omitempty"`
+	DisplayType      string             `json:",omitempty"`
+	EffectiveTaxRate []EffectiveTaxRate `json:",omitempty"`
+}
+
+// EffectiveTaxRate is the value of a tax rate over a period of time. An empty
+// EndDate means the rate is still in effect.
+type EffectiveTaxRate struct {
+	RateValue     Decimal `json:",omitempty"`
+	EffectiveDate Date    `json:",omitempty"`
+	EndDate       *Date   `json:",omitempty"`
+}
+
+// RateOn returns the value of the tax rate in effect on the given date. The
+// start and end dates of each period are inclusive. Rates without a history
+// return RateValue.
+func (t *TaxRate) RateOn(date Date) Decimal {
+	if len(t.EffectiveTaxRate) == 0 {
+		return t.RateValue
+	}
+
+	// Compare calendar days only, so that the time zone QuickBooks attaches
+	// to each date doesn't matter.
+	day := date.Format(secondFormat)
+
+	for _, effectiveTaxRate := range t.EffectiveTaxRate {
+		if effectiveTaxRate.EffectiveDate.Format(secondFormat) > day {
+			continue
+		}
+
+		if effectiveTaxRate.EndDate != nil && effectiveTaxRate.EndDate.Format(secondFormat) < day {
+			continue
+		}
+
+		return effectiveTaxRate.RateValue
+	}
+
+	return t.RateValue
+}
+
+// FindTaxRates gets the full list of TaxRates in the QuickBooks account.
+func (c *Client) FindTaxRates() ([]TaxRate, error) {
+	var resp struct {
+		QueryResponse struct {
+			TaxRates      []TaxRate `json:"TaxRate"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM TaxRate", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no tax rates could be found")
+	}
+
+	taxRates := make([]TaxRate, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM TaxRate ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.TaxRates == nil {
+			return nil, errors.New("no tax rates could be found")
+		}
+
+		taxRates = append(taxRates, resp.QueryResponse.TaxRates...)
+	}
+
+	return taxRates, nil
+}
+
+// FindTaxRateById finds the tax rate by the given id
+func (c *Client) FindTaxRateById(id string) (*TaxRate, error) {
+	var resp struct {
+		TaxRate TaxRate
+		Time    DateTime
+	}
+
+	if err := c.get("taxrate/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.TaxRate, nil
+}
+
+// QueryTaxRates accepts an SQL query and returns all tax rates found using it
+func (c *Client) QueryTaxRates(query string) ([]TaxRate, error) {
+	var resp struct {
+		QueryResponse struct {
+			TaxRates      []TaxRate `json:"TaxRate"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TaxRates == nil {
+		return nil, errors.New("could not find any tax rates")
+	}
+
+	return resp.QueryResponse.TaxRates, nil
+}
@@ -0,0 +1,40 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxRate(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/tax_rate.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		TaxRate TaxRate
+		Time    DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "2", r.TaxRate.Id)
+	assert.Equal(t, "California", r.TaxRate.Name)
+	assert.Equal(t, Decimal("8"), r.TaxRate.RateValue)
+	assert.Equal(t, "1", r.TaxRate.AgencyRef.Value)
+	require.Len(t, r.TaxRate.EffectiveTaxRate, 2)
+	assert.Nil(t, r.TaxRate.EffectiveTaxRate[1].EndDate)
+
+	assert.Equal(t, Decimal("7.5"), r.TaxRate.RateOn(Date{time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)}))
+	assert.Equal(t, Decimal("7.5"), r.TaxRate.RateOn(Date{time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC)}))
+	assert.Equal(t, Decimal("8"), r.TaxRate.RateOn(Date{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)}))
+	assert.Equal(t, Decimal("8"), (&TaxRate{RateValue: "8"}).RateOn(Date{time.Now()}))
+}
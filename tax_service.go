@@ -0,0 +1,56 @@
+package quickbooks
+
+import (
+	"errors"
+)
+
+const (
+	SalesTaxApplicableOn    = "Sales"
+	PurchaseTaxApplicableOn = "Purchase"
+)
+
+// TaxService describes a tax code to create through the tax service, made of
+// new or existing tax rates. Once created, TaxCodeId and each TaxRateId are
+// filled in.
+type TaxService struct {
+	TaxCode        string                 `json:",omitempty"`
+	TaxCodeId      string                 `json:",omitempty"`
+	TaxRateDetails []TaxServiceRateDetail `json:",omitempty"`
+}
+
+// TaxServiceRateDetail is a tax rate within a TaxService. To reuse an existing
+// rate, set only TaxRateId. To create a new one, set TaxRateName, RateValue,
+// TaxAgencyId and TaxApplicableOn.
+type TaxServiceRateDetail struct {
+	TaxRateId       string  `json:",omitempty"`
+	TaxRateName     string  `json:",omitempty"`
+	RateValue       Decimal `json:",omitempty"`
+	TaxAgencyId     string  `json:",omitempty"`
+	TaxApplicableOn string  `json:",omitempty"`
+}
+
+// CreateTaxCode creates a new tax code, and any new tax rates it uses, through
+// the tax service. This is the only way to create tax codes programmatically.
+func (c *Client) CreateTaxCode(taxService *TaxService) (*TaxService, error) {
+	if taxService.TaxCode == "" {
+		return nil, errors.New("missing tax code name")
+	}
+
+	if len(taxService.TaxRateDetails) == 0 {
+		return nil, errors.New("missing tax rate details")
+	}
+
+	for _, rateDetail := range taxService.TaxRateDetails {
+		if rateDetail.TaxRateId == "" && (rateDetail.TaxRateName == "" || rateDetail.RateValue == "" || rateDetail.TaxAgencyId == "") {
+			return nil, errors.New("new tax rates need a name, rate value and tax agency id")
+		}
+	}
+
+	var resp TaxService
+
+	if err := c.post("taxservice/taxcode", taxService, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
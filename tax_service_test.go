@@ -0,0 +1,76 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTaxCode(t *testing.T) {
+	var path string
+	var posted map[string]json.RawMessage
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &posted)
+
+		w.Write([]byte(`{
+			"TaxCode": "Mystate",
+			"TaxCodeId": "4",
+			"TaxRateDetails": [
+				{"TaxRateName": "Mystate tax", "TaxRateId": "7", "RateValue": 7.5, "TaxAgencyId": "1", "TaxApplicableOn": "Sales"},
+				{"TaxRateId": "2"}
+			]
+		}`))
+	}))
+
+	created, err := client.CreateTaxCode(&TaxService{
+		TaxCode: "Mystate",
+		TaxRateDetails: []TaxServiceRateDetail{
+			{TaxRateName: "Mystate tax", RateValue: "7.5", TaxAgencyId: "1", TaxApplicableOn: SalesTaxApplicableOn},
+			{TaxRateId: "2"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/taxservice/taxcode", path)
+	assert.JSONEq(t, `"Mystate"`, string(posted["TaxCode"]))
+	assert.NotContains(t, posted, "TaxCodeId")
+	assert.JSONEq(t, `[
+		{"TaxRateName": "Mystate tax", "RateValue": 7.5, "TaxAgencyId": "1", "TaxApplicableOn": "Sales"},
+		{"TaxRateId": "2"}
+	]`, string(posted["TaxRateDetails"]))
+
+	assert.Equal(t, "4", created.TaxCodeId)
+	require.Len(t, created.TaxRateDetails, 2)
+	assert.Equal(t, "7", created.TaxRateDetails[0].TaxRateId)
+	assert.Equal(t, Decimal("7.5"), created.TaxRateDetails[0].RateValue)
+	assert.Equal(t, "2", created.TaxRateDetails[1].TaxRateId)
+}
+
+func TestCreateTaxCodeValidation(t *testing.T) {
+	var requests int
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	for _, taxService := range []TaxService{
+		{TaxRateDetails: []TaxServiceRateDetail{{TaxRateId: "2"}}},
+		{TaxCode: "Mystate"},
+		{TaxCode: "Mystate", TaxRateDetails: []TaxServiceRateDetail{{TaxRateName: "Mystate tax", TaxAgencyId: "1"}}},
+		{TaxCode: "Mystate", TaxRateDetails: []TaxServiceRateDetail{{TaxRateName: "Mystate tax", RateValue: "7.5"}}},
+		{TaxCode: "Mystate", TaxRateDetails: []TaxServiceRateDetail{{RateValue: "7.5", TaxAgencyId: "1"}}},
+	} {
+		_, err := client.CreateTaxCode(&taxService)
+		assert.Error(t, err)
+	}
+
+	assert.Zero(t, requests)
+}
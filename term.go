@@ -0,0 +1,181 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	StandardTermType   = "STANDARD"
+	DateDrivenTermType = "DATE_DRIVEN"
+)
+
+// Term represents the payment terms of a sale or purchase. Standard terms are
+// due a number of days after the transaction, date driven terms on a day of
+// the month.
+type Term struct {
+	Id                 string   `json:"Id,omitempty"`
+	SyncToken          string   `json:",omitempty"`
+	MetaData           MetaData `json:",omitempty"`
+	Name               string   `json:",omitempty"`
+	Active             *bool    `json:",omitempty"`
+	Type               string   `json:",omitempty"`
+	DiscountPercent    Decimal  `json:",omitempty"`
+	DueDays            *int     `json:",omitempty"`
+	DiscountDays       *int     `json:",omitempty"`
+	DayOfMonthDue      int      `json:",omitempty"`
+	DueNextMonthDays   *int     `json:",omitempty"`
+	DiscountDayOfMonth int      `json:",omitempty"`
+}
+
+// DueDates returns the date payment is due for a transaction dated txnDate,
+// and the last date on which the early payment discount applies. The discount
+// date is zero if the term has no discount.
+func (t *Term) DueDates(txnDate Date) (dueDate Date, discountDate Date, err error) {
+	txn := time.Date(txnDate.Year(), txnDate.Month(), txnDate.Day(), 0, 0, 0, 0, txnDate.Location())
+
+	switch t.Type {
+	case StandardTermType:
+		dueDate = Date{txn.AddDate(0, 0, intValue(t.DueDays))}
+
+		if intValue(t.DiscountDays) > 0 {
+			discountDate = Date{txn.AddDate(0, 0, *t.DiscountDays)}
+		}
+	case DateDrivenTermType:
+		if t.DayOfMonthDue < 1 || t.DayOfMonthDue > 31 {
+			return Date{}, Date{}, errors.New("invalid day of month due")
+		}
+
+		due := dayOfMonth(txn.Year(), txn.Month(), t.DayOfMonthDue, txn.Location())
+
+		// Transactions dated within DueNextMonthDays of this month's due
+		// date, or after it, are due the following month.
+		if due.Before(txn.AddDate(0, 0, intValue(t.DueNextMonthDays))) {
+			due = dayOfMonth(txn.Year(), txn.Month()+1, t.DayOfMonthDue, txn.Location())
+		}
+
+		dueDate = Date{due}
+
+		if t.DiscountDayOfMonth > 0 {
+			discount := dayOfMonth(due.Year(), due.Month(), t.DiscountDayOfMonth, due.Location())
+			if discount.After(due) {
+				discount = dayOfMonth(due.Year(), due.Month()-1, t.DiscountDayOfMonth, due.Location())
+			}
+
+			discountDate = Date{discount}
+		}
+	default:
+		return Date{}, Date{}, errors.New("unknown term type: " + t.Type)
+	}
+
+	return dueDate, discountDate, nil
+}
+
+// dayOfMonth returns the given day of the month, clamped to the last day of
+// months that are too short. Months outside 1-12 are normalized.
+func dayOfMonth(year int, month time.Month, day int, loc *time.Location) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// CreateTerm creates the given Term on the QuickBooks server, returning the
+// resulting Term object.
+func (c *Client) CreateTerm(term *Term) (*Term, error) {
+	var resp struct {
+		Term Term
+		Time DateTime
+	}
+
+	if err := c.post("term", term, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Term, nil
+}
+
+// FindTerms gets the full list of Terms in the QuickBooks account.
+func (c *Client) FindTerms() ([]Term, error) {
+	var resp struct {
+		QueryResponse struct {
+			Terms         []Term `json:"Term"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM Term", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no terms could be found")
+	}
+
+	terms := make([]Term, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM Term ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.Terms == nil {
+			return nil, errors.New("no terms could be found")
+		}
+
+		terms = append(terms, resp.QueryResponse.Terms...)
+	}
+
+	return terms, nil
+}
+
+// FindTermById finds the term by the given id
+func (c *Client) FindTermById(id string) (*Term, error) {
+	var resp struct {
+		Term Term
+		Time DateTime
+	}
+
+	if err := c.get("term/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Term, nil
+}
+
+// QueryTerms accepts an SQL query and returns all terms found using it
+func (c *Client) QueryTerms(query string) ([]Term, error) {
+	var resp struct {
+		QueryResponse struct {
+			Terms         []Term `json:"Term"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.Terms == nil {
+		return nil, errors.New("could not find any terms")
+	}
+
+	return resp.QueryResponse.Terms, nil
+}
+
+// UpdateTerm updates the term
+func (c *Client) UpdateTerm(term *Term, opts ...UpdateOption) (*Term, error) {
+	if term.Id == "" {
+		return nil, errors.New("missing term id")
+	}
+
+	return update(c, "term", "Term", term, c.FindTermById, opts)
+}
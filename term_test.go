@@ -0,0 +1,70 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerm(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/term.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		Term Term
+		Time DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "4", r.Term.Id)
+	assert.Equal(t, StandardTermType, r.Term.Type)
+	assert.Equal(t, Decimal("2"), r.Term.DiscountPercent)
+	assert.Equal(t, Int(30), r.Term.DueDays)
+	assert.Equal(t, Int(10), r.Term.DiscountDays)
+
+	dueDate, discountDate, err := r.Term.DueDates(Date{time.Date(2015, 1, 25, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	assert.Equal(t, "2015-02-24", dueDate.Format("2006-01-02"))
+	assert.Equal(t, "2015-02-04", discountDate.Format("2006-01-02"))
+}
+
+func TestTermDueDatesDateDriven(t *testing.T) {
+	term := Term{Type: DateDrivenTermType, DayOfMonthDue: 31, DueNextMonthDays: Int(5), DiscountDayOfMonth: 20}
+
+	cases := []struct {
+		txnDate      time.Time
+		dueDate      string
+		discountDate string
+	}{
+		{time.Date(2015, 1, 10, 0, 0, 0, 0, time.UTC), "2015-01-31", "2015-01-20"},
+		{time.Date(2015, 1, 27, 0, 0, 0, 0, time.UTC), "2015-02-28", "2015-02-20"},
+		{time.Date(2016, 1, 31, 0, 0, 0, 0, time.UTC), "2016-02-29", "2016-02-20"},
+		{time.Date(2015, 4, 10, 0, 0, 0, 0, time.UTC), "2015-04-30", "2015-04-20"},
+	}
+
+	for _, c := range cases {
+		dueDate, discountDate, err := term.DueDates(Date{c.txnDate})
+		require.NoError(t, err)
+		assert.Equal(t, c.dueDate, dueDate.Format("2006-01-02"), c.txnDate.String())
+		assert.Equal(t, c.discountDate, discountDate.Format("2006-01-02"), c.txnDate.String())
+	}
+
+	term = Term{Type: DateDrivenTermType, DayOfMonthDue: 15}
+	dueDate, discountDate, err := term.DueDates(Date{time.Date(2015, 12, 16, 0, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	assert.Equal(t, "2016-01-15", dueDate.Format("2006-01-02"))
+	assert.True(t, discountDate.IsZero())
+
+	_, _, err = (&Term{Type: "WEEKLY"}).DueDates(Date{time.Now()})
+	assert.Error(t, err)
+}
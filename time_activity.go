@@ -0,0 +1,207 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	TimeActivityEmployeeNameOf = "Employee"
+	TimeActivityVendorNameOf   = "Vendor"
+)
+
+// TimeActivity records time worked by an employee or vendor, optionally
+// billable to a customer.
+type TimeActivity struct {
+	Id            string         `json:"Id,omitempty"`
+	SyncToken     string         `json:",omitempty"`
+	MetaData      MetaData       `json:",omitempty"`
+	TxnDate       *Date          `json:",omitempty"`
+	NameOf        string         `json:",omitempty"`
+	EmployeeRef   *ReferenceType `json:",omitempty"`
+	VendorRef     *ReferenceType `json:",omitempty"`
+	CustomerRef   *ReferenceType `json:",omitempty"`
+	ItemRef       *ReferenceType `json:",omitempty"`
+	ClassRef      *ReferenceType `json:",omitempty"`
+	DepartmentRef *ReferenceType `json:",omitempty"`
+	// PayrollItemRef
+	BillableStatus BillableStatusEnum `json:",omitempty"`
+	Taxable        *bool              `json:",omitempty"`
+	HourlyRate     Decimal            `json:",omitempty"`
+	CostRate       Decimal            `json:",omitempty"`
+	// Either Hours and Minutes, or StartTime and EndTime are used.
+	Hours        *int      `json:",omitempty"`
+	Minutes      *int      `json:",omitempty"`
+	StartTime    *DateTime `json:",omitempty"`
+	EndTime      *DateTime `json:",omitempty"`
+	BreakHours   *int      `json:",omitempty"`
+	BreakMinutes *int      `json:",omitempty"`
+	Description  *string   `json:",omitempty"`
+}
+
+// Duration returns the time worked, excluding breaks.
+func (t *TimeActivity) Duration() time.Duration {
+	hours, minutes := intValue(t.Hours), intValue(t.Minutes)
+	if hours != 0 || minutes != 0 || t.StartTime == nil || t.EndTime == nil {
+		return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	}
+
+	breakTime := time.Duration(intValue(t.BreakHours))*time.Hour + time.Duration(intValue(t.BreakMinutes))*time.Minute
+
+	return t.EndTime.Sub(t.StartTime.Time) - breakTime
+}
+
+// CreateTimeActivity creates the given TimeActivity on the QuickBooks server,
+// returning the resulting TimeActivity object.
+func (c *Client) CreateTimeActivity(timeActivity *TimeActivity) (*TimeActivity, error) {
+	var resp struct {
+		TimeActivity TimeActivity
+		Time         DateTime
+	}
+
+	if err := c.post("timeactivity", timeActivity, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.TimeActivity, nil
+}
+
+// DeleteTimeActivity deletes the time activity
+func (c *Client) DeleteTimeActivity(timeActivity *TimeActivity) error {
+	if timeActivity.Id == "" || timeActivity.SyncToken == "" {
+		return errors.New("missing id/sync token")
+	}
+
+	return c.post("timeactivity", timeActivity, nil, map[string]string{"operation": "delete"})
+}
+
+// FindTimeActivities gets the full list of TimeActivities in the QuickBooks account.
+func (c *Client) FindTimeActivities() ([]TimeActivity, error) {
+	var resp struct {
+		QueryResponse struct {
+			TimeActivities []TimeActivity `json:"TimeActivity"`
+			MaxResults     int
+			StartPosition  int
+			TotalCount     int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM TimeActivity", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no time activities could be found")
+	}
+
+	timeActivities := make([]TimeActivity, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM TimeActivity ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.TimeActivities == nil {
+			return nil, errors.New("no time activities could be found")
+		}
+
+		timeActivities = append(timeActivities, resp.QueryResponse.TimeActivities...)
+	}
+
+	return timeActivities, nil
+}
+
+// FindTimeActivityById finds the time activity by the given id
+func (c *Client) FindTimeActivityById(id string) (*TimeActivity, error) {
+	var resp struct {
+		TimeActivity TimeActivity
+		Time         DateTime
+	}
+
+	if err := c.get("timeactivity/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.TimeActivity, nil
+}
+
+// QueryTimeActivities accepts an SQL query and returns all time activities found using it
+func (c *Client) QueryTimeActivities(query string) ([]TimeActivity, error) {
+	var resp struct {
+		QueryResponse struct {
+			TimeActivities []TimeActivity `json:"TimeActivity"`
+			StartPosition  int
+			MaxResults     int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TimeActivities == nil {
+		return nil, errors.New("could not find any time activities")
+	}
+
+	return resp.QueryResponse.TimeActivities, nil
+}
+
+// UpdateTimeActivity updates the time activity
+func (c *Client) UpdateTimeActivity(timeActivity *TimeActivity, opts ...UpdateOption) (*TimeActivity, error) {
+	if timeActivity.Id == "" {
+		return nil, errors.New("missing time activity id")
+	}
+
+	return update(c, "timeactivity", "TimeActivity", timeActivity, c.FindTimeActivityById, opts)
+}
+
+// UnbilledTimeInvoiceLines turns the billable time activities for the given
+// customer into sales item lines for an invoice, one per activity. Activities
+// for other customers or that aren't billable are skipped. Each line is linked
+// to its activity, so that QuickBooks marks the time as billed once the
+// invoice is saved.
+func UnbilledTimeInvoiceLines(customerId string, timeActivities []TimeActivity) ([]Line, error) {
+	var lines []Line
+
+	for _, timeActivity := range timeActivities {
+		if timeActivity.CustomerRef == nil || timeActivity.CustomerRef.Value != customerId {
+			continue
+		}
+
+		if timeActivity.BillableStatus != BillableStatusBillable {
+			continue
+		}
+
+		if timeActivity.ItemRef == nil {
+			return nil, errors.New("time activity " + timeActivity.Id + " has no item to bill")
+		}
+
+		// Work in whole seconds so that the amount is exact; QuickBooks only
+		// takes five decimal places for the quantity.
+		seconds := NewDecimalFromInt(int64(timeActivity.Duration() / time.Second))
+
+		// Copy the date so that the line doesn't share it with the activity.
+		var serviceDate *Date
+		if timeActivity.TxnDate != nil {
+			date := *timeActivity.TxnDate
+			serviceDate = &date
+		}
+
+		line := NewSalesItemLine(seconds.Mul(timeActivity.HourlyRate).Div("3600", 2), SalesItemLineDetail{
+			ItemRef:     *timeActivity.ItemRef,
+			ClassRef:    timeActivity.ClassRef,
+			UnitPrice:   timeActivity.HourlyRate,
+			Qty:         seconds.Div("3600", 5),
+			ServiceDate: serviceDate,
+		})
+		line.Description = stringValue(timeActivity.Description)
+		line.LinkedTxn = []LinkedTxn{{TxnID: timeActivity.Id, TxnType: "TimeActivity"}}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,68 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeActivity(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/time_activity.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		TimeActivity TimeActivity
+		Time         DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "1", r.TimeActivity.Id)
+	assert.Equal(t, TimeActivityEmployeeNameOf, r.TimeActivity.NameOf)
+	require.NotNil(t, r.TimeActivity.EmployeeRef)
+	assert.Equal(t, "55", r.TimeActivity.EmployeeRef.Value)
+	assert.Nil(t, r.TimeActivity.VendorRef)
+	assert.Equal(t, "1", r.TimeActivity.CustomerRef.Value)
+	assert.Equal(t, "6", r.TimeActivity.ItemRef.Value)
+	assert.Equal(t, BillableStatusBillable, r.TimeActivity.BillableStatus)
+	assert.Equal(t, Decimal("25"), r.TimeActivity.HourlyRate)
+	assert.Equal(t, 3*time.Hour+30*time.Minute, r.TimeActivity.Duration())
+
+	lines, err := UnbilledTimeInvoiceLines("1", []TimeActivity{r.TimeActivity})
+	require.NoError(t, err)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "SalesItemLineDetail", lines[0].DetailType)
+	assert.Equal(t, Decimal("87.50"), lines[0].Amount)
+	assert.Equal(t, Decimal("3.50000"), lines[0].SalesItemLineDetail.Qty)
+	assert.Equal(t, "6", lines[0].SalesItemLineDetail.ItemRef.Value)
+	assert.Equal(t, "Garden maintenance", lines[0].Description)
+	assert.Equal(t, []LinkedTxn{{TxnID: "1", TxnType: "TimeActivity"}}, lines[0].LinkedTxn)
+
+	b, err := json.Marshal(lines[0])
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.JSONEq(t, `[{"TxnId": "1", "TxnType": "TimeActivity"}]`, string(fields["LinkedTxn"]))
+
+	lines, err = UnbilledTimeInvoiceLines("2", []TimeActivity{r.TimeActivity})
+	require.NoError(t, err)
+	assert.Empty(t, lines)
+}
+
+func TestTimeActivityDurationFromStartAndEnd(t *testing.T) {
+	start := DateTime{time.Date(2014, 9, 17, 8, 0, 0, 0, time.UTC)}
+	end := DateTime{time.Date(2014, 9, 17, 17, 0, 0, 0, time.UTC)}
+
+	timeActivity := TimeActivity{StartTime: &start, EndTime: &end, BreakHours: Int(1)}
+	assert.Equal(t, 8*time.Hour, timeActivity.Duration())
+}
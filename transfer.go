@@ -0,0 +1,184 @@
+package quickbooks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Transfer represents a movement of funds between two balance sheet accounts.
+type Transfer struct {
+	Id             string         `json:"Id,omitempty"`
+	SyncToken      string         `json:",omitempty"`
+	MetaData       MetaData       `json:",omitempty"`
+	TxnDate        *Date          `json:",omitempty"`
+	PrivateNote    *string        `json:",omitempty"`
+	FromAccountRef ReferenceType  `json:",omitempty"`
+	ToAccountRef   ReferenceType  `json:",omitempty"`
+	Amount         Decimal        `json:",omitempty"`
+	CurrencyRef    ReferenceType  `json:",omitempty"`
+	ExchangeRate   Decimal        `json:",omitempty"`
+	RecurringInfo  *RecurringInfo `json:",omitempty"`
+}
+
+// CreateTransfer creates the given Transfer on the QuickBooks server,
+// returning the resulting Transfer object. Both accounts are looked up first
+// and must be balance sheet accounts.
+func (c *Client) CreateTransfer(transfer *Transfer) (*Transfer, error) {
+	if transfer.FromAccountRef.Value == "" || transfer.ToAccountRef.Value == "" {
+		return nil, errors.New("missing from/to account")
+	}
+
+	if err := c.validateTransferAccounts(transfer); err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Transfer Transfer
+		Time     DateTime
+	}
+
+	if err := c.post("transfer", transfer, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Transfer, nil
+}
+
+// DeleteTransfer deletes the transfer
+func (c *Client) DeleteTransfer(transfer *Transfer) error {
+	if transfer.Id == "" || transfer.SyncToken == "" {
+		return errors.New("missing id/sync token")
+	}
+
+	return c.post("transfer", transfer, nil, map[string]string{"operation": "delete"})
+}
+
+// FindTransfers gets the full list of Transfers in the QuickBooks account.
+func (c *Client) FindTransfers() ([]Transfer, error) {
+	var resp struct {
+		QueryResponse struct {
+			Transfers     []Transfer `json:"Transfer"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM Transfer", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no transfers could be found")
+	}
+
+	transfers := make([]Transfer, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM Transfer ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.Transfers == nil {
+			return nil, errors.New("no transfers could be found")
+		}
+
+		transfers = append(transfers, resp.QueryResponse.Transfers...)
+	}
+
+	return transfers, nil
+}
+
+// FindTransferById finds the transfer by the given id
+func (c *Client) FindTransferById(id string) (*Transfer, error) {
+	var resp struct {
+		Transfer Transfer
+		Time     DateTime
+	}
+
+	if err := c.get("transfer/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.Transfer, nil
+}
+
+// QueryTransfers accepts an SQL query and returns all transfers found using it
+func (c *Client) QueryTransfers(query string) ([]Transfer, error) {
+	var resp struct {
+		QueryResponse struct {
+			Transfers     []Transfer `json:"Transfer"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.Transfers == nil {
+		return nil, errors.New("could not find any transfers")
+	}
+
+	return resp.QueryResponse.Transfers, nil
+}
+
+// UpdateTransfer updates the transfer. Any account that is being changed must
+// be a balance sheet account.
+func (c *Client) UpdateTransfer(transfer *Transfer, opts ...UpdateOption) (*Transfer, error) {
+	if transfer.Id == "" {
+		return nil, errors.New("missing transfer id")
+	}
+
+	if err := c.validateTransferAccounts(transfer); err != nil {
+		return nil, err
+	}
+
+	return update(c, "transfer", "Transfer", transfer, c.FindTransferById, opts)
+}
+
+// validateTransferAccounts checks that the transfer is between two different
+// balance sheet accounts. An account left unset in a sparse update keeps its
+// current value, which was checked when the transfer was created, but the
+// account being changed is compared against it.
+func (c *Client) validateTransferAccounts(transfer *Transfer) error {
+	from, to := transfer.FromAccountRef.Value, transfer.ToAccountRef.Value
+
+	if transfer.Id != "" && (from == "") != (to == "") {
+		existing, err := c.FindTransferById(transfer.Id)
+		if err != nil {
+			return err
+		}
+
+		if from == "" {
+			from = existing.FromAccountRef.Value
+		} else {
+			to = existing.ToAccountRef.Value
+		}
+	}
+
+	if from != "" && from == to {
+		return errors.New("cannot transfer funds to the same account")
+	}
+
+	for _, ref := range []ReferenceType{transfer.FromAccountRef, transfer.ToAccountRef} {
+		if ref.Value == "" {
+			continue
+		}
+
+		account, err := c.FindAccountById(ref.Value)
+		if err != nil {
+			return fmt.Errorf("failed to find account %s: %v", ref.Value, err)
+		}
+
+		if !account.IsBalanceSheet() {
+			return fmt.Errorf("account %s is a %s account, not a balance sheet account", ref.Value, account.AccountType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountServer is a fake QuickBooks company holding the given accounts. Any
+// post is recorded and echoed back as the entity called name. Other objects
+// can be read from objects, by path.
+type accountServer struct {
+	accounts map[string]Account
+	name     string
+	objects  map[string]interface{}
+	posts    []map[string]json.RawMessage
+}
+
+func (s *accountServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/account/"):
+		account, ok := s.accounts[strings.TrimPrefix(r.URL.Path, "/account/")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Fault": {"Error": [{"Message": "Object Not Found", "code": "610"}], "type": "ValidationFault"}}`))
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"Account": account})
+	case r.Method == http.MethodGet && s.objects[r.URL.Path] != nil:
+		json.NewEncoder(w).Encode(map[string]interface{}{s.name: s.objects[r.URL.Path]})
+	case r.Method == http.MethodPost:
+		body, _ := ioutil.ReadAll(r.Body)
+
+		var fields map[string]json.RawMessage
+		json.Unmarshal(body, &fields)
+		s.posts = append(s.posts, fields)
+
+		json.NewEncoder(w).Encode(map[string]interface{}{s.name: json.RawMessage(body)})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTransferServer() *accountServer {
+	return &accountServer{
+		accounts: map[string]Account{
+			"35": {Id: "35", Name: "Checking", AccountType: BankAccountType},
+			"36": {Id: "36", Name: "Savings", AccountType: BankAccountType},
+			"64": {Id: "64", Name: "Office Expenses", AccountType: ExpenseAccountType},
+		},
+		name: "Transfer",
+		objects: map[string]interface{}{
+			"/transfer/12": Transfer{
+				Id:             "12",
+				SyncToken:      "0",
+				FromAccountRef: ReferenceType{Value: "35"},
+				ToAccountRef:   ReferenceType{Value: "36"},
+				Amount:         "120.00",
+			},
+		},
+	}
+}
+
+func TestCreateTransfer(t *testing.T) {
+	server := newTransferServer()
+	client := newTestClient(t, server)
+
+	transfer, err := client.CreateTransfer(&Transfer{
+		FromAccountRef: ReferenceType{Value: "35"},
+		ToAccountRef:   ReferenceType{Value: "36"},
+		Amount:         "120.00",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Decimal("120.00"), transfer.Amount)
+	assert.Len(t, server.posts, 1)
+}
+
+func TestCreateTransferRejectsAccounts(t *testing.T) {
+	server := newTransferServer()
+	client := newTestClient(t, server)
+
+	for _, transfer := range []Transfer{
+		{FromAccountRef: ReferenceType{Value: "35"}, ToAccountRef: ReferenceType{Value: "64"}},
+		{FromAccountRef: ReferenceType{Value: "35"}, ToAccountRef: ReferenceType{Value: "35"}},
+		{FromAccountRef: ReferenceType{Value: "35"}, ToAccountRef: ReferenceType{Value: "99"}},
+		{FromAccountRef: ReferenceType{Value: "35"}},
+		{ToAccountRef: ReferenceType{Value: "36"}},
+	} {
+		_, err := client.CreateTransfer(&transfer)
+		assert.Error(t, err)
+	}
+
+	for _, transfer := range []Transfer{
+		{Id: "12", ToAccountRef: ReferenceType{Value: "64"}},
+		{Id: "12", ToAccountRef: ReferenceType{Value: "35"}},
+		{Id: "12", FromAccountRef: ReferenceType{Value: "36"}},
+	} {
+		_, err := client.UpdateTransfer(&transfer)
+		assert.Error(t, err)
+	}
+
+	assert.Empty(t, server.posts)
+}
+
+func TestUpdateTransferOneAccount(t *testing.T) {
+	server := newTransferServer()
+	client := newTestClient(t, server)
+
+	_, err := client.UpdateTransfer(&Transfer{Id: "12", FromAccountRef: ReferenceType{Value: "64"}})
+	assert.Error(t, err)
+	assert.Empty(t, server.posts)
+
+	server.accounts["37"] = Account{Id: "37", Name: "Petty Cash", AccountType: BankAccountType}
+
+	_, err = client.UpdateTransfer(&Transfer{Id: "12", ToAccountRef: ReferenceType{Value: "37"}})
+	require.NoError(t, err)
+
+	require.Len(t, server.posts, 1)
+	assert.JSONEq(t, `{"value": "37"}`, string(server.posts[0]["ToAccountRef"]))
+	assert.JSONEq(t, `"0"`, string(server.posts[0]["SyncToken"]))
+}
@@ -0,0 +1,41 @@
+package quickbooks
+
+// treeParents arranges a list of objects into a tree, given each object's id
+// and the id of its parent from ParentRef, or "" for none. It returns the
+// index of each object's parent in the list, or -1 for a top-level object. An
+// object whose parent isn't in the list, or whose parent would make a cycle,
+// is treated as top-level.
+func treeParents(ids []string, parentIds []string) []int {
+	indexes := make(map[string]int, len(ids))
+	for i, id := range ids {
+		indexes[id] = i
+	}
+
+	parents := make([]int, len(ids))
+	for i := range parents {
+		parents[i] = -1
+	}
+
+	for i, parentId := range parentIds {
+		parent, ok := indexes[parentId]
+		if parentId == "" || !ok {
+			continue
+		}
+
+		// Linking to the parent makes a cycle if the object is already the
+		// parent or one of its ancestors.
+		cycle := false
+		for ancestor := parent; ancestor >= 0; ancestor = parents[ancestor] {
+			if ancestor == i {
+				cycle = true
+				break
+			}
+		}
+
+		if !cycle {
+			parents[i] = parent
+		}
+	}
+
+	return parents
+}
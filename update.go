@@ -0,0 +1,407 @@
+package quickbooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// staleObjectErrorCode is the QuickBooks error code for an update sent with an
+// out of date SyncToken.
+const staleObjectErrorCode = "5010"
+
+// UpdateOption changes how an Update* method updates an object.
+type UpdateOption func(*updateOptions)
+
+type updateOptions struct {
+	keepSyncToken bool
+	full          bool
+}
+
+// KeepSyncToken sends the object's SyncToken as it is, instead of replacing it
+// with the latest one first. If the object has changed in QuickBooks since it
+// was read, the update fails with a *ConflictError rather than overwriting
+// the other change.
+func KeepSyncToken() UpdateOption {
+	return func(o *updateOptions) {
+		o.keepSyncToken = true
+	}
+}
+
+// FullUpdate replaces the whole object instead of only changing the fields
+// that are set, so that lines, addresses and other nested objects can be
+// removed. Anything left unset is cleared, so the object must have been
+// fetched from QuickBooks first. Fields that this package doesn't model are
+// copied from the current version of the object, so that they aren't
+// cleared too.
+func FullUpdate() UpdateOption {
+	return func(o *updateOptions) {
+		o.full = true
+	}
+}
+
+func newUpdateOptions(opts []UpdateOption) updateOptions {
+	var o updateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// ConflictError is returned by an update that QuickBooks rejected because the
+// object changed after its SyncToken was read.
+type ConflictError struct {
+	Failure Failure
+	// Current is the latest version of the object, of the same type as the
+	// one being updated, or nil if it couldn't be fetched.
+	Current interface{}
+}
+
+func (e *ConflictError) Error() string {
+	return "stale object: " + e.Failure.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Failure
+}
+
+// conflictError turns err into a *ConflictError holding the current version
+// of the object if it is a stale object failure, and returns it unchanged
+// otherwise.
+func conflictError(err error, current func() (interface{}, error)) error {
+	var failure Failure
+	if !errors.As(err, &failure) {
+		return err
+	}
+
+	for _, e := range failure.Fault.Error {
+		if e.Code == staleObjectErrorCode {
+			conflict := &ConflictError{Failure: failure}
+			if object, err := current(); err == nil {
+				conflict.Current = object
+			}
+			return conflict
+		}
+	}
+
+	return err
+}
+
+// updatePayload adds the sparse flag to an object being updated.
+type updatePayload struct {
+	object interface{}
+	sparse bool
+}
+
+func (p updatePayload) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(p.object)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 2 || b[0] != '{' {
+		return nil, errors.New("can't update a value that isn't an object")
+	}
+
+	sparse := `{"sparse":false`
+	if p.sparse {
+		sparse = `{"sparse":true`
+	}
+
+	if len(b) == 2 {
+		return []byte(sparse + "}"), nil
+	}
+
+	return append([]byte(sparse+","), b[1:]...), nil
+}
+
+// update sends an update of object, whose JSON key in requests and responses
+// is name, to endpoint. Unless KeepSyncToken is given, the object's SyncToken
+// is first replaced with the latest one from find, or from the current object
+// read for a full update, as is its Id if it has none yet.
+func update[T any](c *Client, endpoint string, name string, object *T, find func(id string) (*T, error), opts []UpdateOption) (*T, error) {
+	o := newUpdateOptions(opts)
+
+	fields := reflect.ValueOf(object).Elem()
+	id := fields.FieldByName("Id")
+	syncToken := fields.FieldByName("SyncToken")
+
+	if o.full && !fetched(fields) {
+		return nil, errors.New("a full update needs an object fetched from QuickBooks")
+	}
+
+	// current is the object as QuickBooks has it, for a full update to copy
+	// unmodelled fields from. Its SyncToken is used too, rather than reading
+	// the object twice.
+	var current json.RawMessage
+	var existing *T
+
+	if o.full {
+		var resp map[string]json.RawMessage
+		if err := c.get(c.readPath(endpoint, id.String()), &resp, nil); err != nil {
+			return nil, err
+		}
+		if current = resp[name]; current == nil {
+			return nil, errors.New("could not read the current " + name)
+		}
+
+		if !o.keepSyncToken {
+			existing = new(T)
+			if err := json.Unmarshal(current, existing); err != nil {
+				return nil, err
+			}
+		}
+	} else if !o.keepSyncToken {
+		var err error
+		if existing, err = find(id.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	if existing != nil {
+		existingFields := reflect.ValueOf(existing).Elem()
+		if id.String() == "" {
+			id.SetString(existingFields.FieldByName("Id").String())
+		}
+		syncToken.SetString(existingFields.FieldByName("SyncToken").String())
+	}
+
+	var payload interface{} = object
+
+	if o.full {
+		b, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+
+		if payload, err = keepUnmodelled(reflect.TypeOf(object).Elem(), b, current); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp map[string]json.RawMessage
+
+	if err := c.post(endpoint, updatePayload{object: payload, sparse: !o.full}, &resp, nil); err != nil {
+		return nil, conflictError(err, func() (interface{}, error) {
+			return find(id.String())
+		})
+	}
+
+	var updated T
+	if err := json.Unmarshal(resp[name], &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// fetched reports whether fields, the fields of an object, include metadata
+// set by QuickBooks, so that the object holds everything QuickBooks has for
+// it rather than only the fields a caller filled in.
+func fetched(fields reflect.Value) bool {
+	for i := 0; i < fields.NumField(); i++ {
+		if metaData, ok := fields.Field(i).Interface().(MetaData); ok {
+			return metaData.CreateTime != nil && !metaData.CreateTime.IsZero()
+		}
+	}
+
+	return false
+}
+
+// readPath returns the path an object sent to endpoint is read from.
+func (c *Client) readPath(endpoint string, id string) string {
+	switch endpoint {
+	case "preferences":
+		return "preferences"
+	case "companyInfo":
+		return "companyinfo/" + c.realmId
+	}
+
+	return endpoint + "/" + id
+}
+
+// keepUnmodelled adds the fields of current, an object as QuickBooks has it,
+// that t doesn't model to object, the JSON of a t being sent in a full update.
+// Fields of nested objects are kept the same way, as are those of objects in
+// arrays, such as lines, that have the same Id in both.
+func keepUnmodelled(t reflect.Type, object []byte, current []byte) (json.RawMessage, error) {
+	var objectFields, currentFields map[string]json.RawMessage
+	if err := json.Unmarshal(object, &objectFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(current, &currentFields); err != nil {
+		return nil, err
+	}
+
+	if objectFields == nil {
+		return object, nil
+	}
+
+	modelled := jsonFields(t)
+
+	for key, value := range currentFields {
+		fieldType, ok := modelled[strings.ToLower(key)]
+		if !ok {
+			// sparse is added to every update.
+			if _, set := objectFields[key]; !set && key != "sparse" {
+				objectFields[key] = value
+			}
+			continue
+		}
+
+		field, set := objectFields[key]
+		if !set {
+			continue
+		}
+
+		fieldType = elemType(fieldType)
+
+		var err error
+		switch {
+		case fieldType.Kind() == reflect.Struct && isJSON(field, '{') && isJSON(value, '{'):
+			objectFields[key], err = keepUnmodelled(fieldType, field, value)
+		case fieldType.Kind() == reflect.Slice && isJSON(field, '[') && isJSON(value, '['):
+			if elem := elemType(fieldType.Elem()); elem.Kind() == reflect.Struct {
+				objectFields[key], err = keepUnmodelledById(elem, field, value)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(objectFields)
+}
+
+// keepUnmodelledById applies keepUnmodelled to each object in objects, a
+// JSON array of t, that has the same Id as one in current. Objects without an
+// Id, such as new lines, are left as they are.
+func keepUnmodelledById(t reflect.Type, objects []byte, current []byte) (json.RawMessage, error) {
+	var objectItems, currentItems []json.RawMessage
+	if err := json.Unmarshal(objects, &objectItems); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(current, &currentItems); err != nil {
+		return nil, err
+	}
+
+	currentById := make(map[string]json.RawMessage)
+	for _, item := range currentItems {
+		if id := itemId(item); id != "" {
+			currentById[id] = item
+		}
+	}
+
+	for i, item := range objectItems {
+		currentItem, ok := currentById[itemId(item)]
+		if !ok || !isJSON(item, '{') {
+			continue
+		}
+
+		merged, err := keepUnmodelled(t, item, currentItem)
+		if err != nil {
+			return nil, err
+		}
+		objectItems[i] = merged
+	}
+
+	return json.Marshal(objectItems)
+}
+
+// itemId returns the Id of a JSON object, or "" if it has none.
+func itemId(item json.RawMessage) string {
+	var identified struct {
+		Id string
+	}
+	if !isJSON(item, '{') || json.Unmarshal(item, &identified) != nil {
+		return ""
+	}
+
+	return identified.Id
+}
+
+// isJSON reports whether b is a JSON value starting with the delimiter, '{'
+// for an object or '[' for an array.
+func isJSON(b []byte, delimiter byte) bool {
+	b = bytes.TrimLeft(b, " \t\r\n")
+	return len(b) > 0 && b[0] == delimiter
+}
+
+// elemType returns t with any pointers removed.
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// jsonFields returns the types of the fields of struct type t by their
+// lowercased JSON names, which encoding/json matches case insensitively.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			if embedded := elemType(field.Type); embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedType := range jsonFields(embedded) {
+					if _, ok := fields[embeddedName]; !ok {
+						fields[embeddedName] = embeddedType
+					}
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = field.Type
+	}
+
+	return fields
+}
+
+// ReadModifyWrite reads an object with read, changes it with modify and
+// saves it with write, sending the SyncToken it was read with. If another
+// change got there first, modify is applied again to the current version, up
+// to attempts times in all. write is an Update* method, such as
+// client.UpdateCustomer.
+func ReadModifyWrite[T any](read func() (*T, error), modify func(*T) error, write func(*T, ...UpdateOption) (*T, error), attempts int) (*T, error) {
+	object, err := read()
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err = modify(object); err != nil {
+			return nil, err
+		}
+
+		updated, err := write(object, KeepSyncToken())
+		if err == nil {
+			return updated, nil
+		}
+
+		var conflict *ConflictError
+		if attempt >= attempts || !errors.As(err, &conflict) {
+			return nil, err
+		}
+
+		if current, ok := conflict.Current.(*T); ok {
+			object = current
+		} else if object, err = read(); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// customerServer is a fake QuickBooks company holding one customer, which
+// rejects updates with a stale SyncToken like QuickBooks does.
+type customerServer struct {
+	customer Customer
+	posts    []map[string]json.RawMessage
+	// beforePost, if set, runs before each update is checked, to simulate
+	// another writer.
+	beforePost func(*Customer)
+}
+
+func (s *customerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/customer/"+s.customer.Id:
+		json.NewEncoder(w).Encode(map[string]interface{}{"Customer": s.customer})
+	case r.Method == http.MethodPost && r.URL.Path == "/customer":
+		body, _ := ioutil.ReadAll(r.Body)
+
+		var fields map[string]json.RawMessage
+		json.Unmarshal(body, &fields)
+		s.posts = append(s.posts, fields)
+
+		if s.beforePost != nil {
+			s.beforePost(&s.customer)
+		}
+
+		var update Customer
+		json.Unmarshal(body, &update)
+
+		if update.SyncToken != s.customer.SyncToken {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Fault": {"Error": [{"Message": "Stale Object Error", "code": "5010"}], "type": "ValidationFault"}}`))
+			return
+		}
+
+		if update.DisplayName != "" {
+			s.customer.DisplayName = update.DisplayName
+		}
+		if update.Notes != nil {
+			s.customer.Notes = update.Notes
+		}
+		s.bump()
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"Customer": s.customer})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *customerServer) bump() {
+	syncToken, _ := strconv.Atoi(s.customer.SyncToken)
+	s.customer.SyncToken = strconv.Itoa(syncToken + 1)
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	endpoint, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	return &Client{Client: server.Client(), endpoint: endpoint, minorVersion: "65"}
+}
+
+func TestUpdateRefreshesSyncToken(t *testing.T) {
+	server := &customerServer{customer: Customer{Id: "58", SyncToken: "3", DisplayName: "Acme"}}
+	client := newTestClient(t, server)
+
+	updated, err := client.UpdateCustomer(&Customer{Id: "58", SyncToken: "1", DisplayName: "Acme Holdings"})
+	require.NoError(t, err)
+	assert.Equal(t, "4", updated.SyncToken)
+	assert.Equal(t, "Acme Holdings", updated.DisplayName)
+
+	require.Len(t, server.posts, 1)
+	assert.JSONEq(t, `true`, string(server.posts[0]["sparse"]))
+	assert.JSONEq(t, `"3"`, string(server.posts[0]["SyncToken"]))
+}
+
+func TestUpdateKeepSyncTokenConflict(t *testing.T) {
+	server := &customerServer{customer: Customer{Id: "58", SyncToken: "3", DisplayName: "Acme"}}
+	client := newTestClient(t, server)
+
+	_, err := client.UpdateCustomer(&Customer{Id: "58", SyncToken: "1", DisplayName: "Acme Holdings"}, KeepSyncToken())
+	require.Error(t, err)
+
+	var conflict *ConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, "5010", conflict.Failure.Fault.Error[0].Code)
+
+	current, ok := conflict.Current.(*Customer)
+	require.True(t, ok)
+	assert.Equal(t, "3", current.SyncToken)
+	assert.Equal(t, "Acme", current.DisplayName)
+
+	var failure Failure
+	assert.True(t, errors.As(err, &failure))
+}
+
+func TestReadModifyWrite(t *testing.T) {
+	server := &customerServer{customer: Customer{Id: "58", SyncToken: "3", DisplayName: "Acme", Notes: String("a")}}
+	client := newTestClient(t, server)
+
+	// Another writer gets in first, once.
+	server.beforePost = func(customer *Customer) {
+		customer.Notes = String(*customer.Notes + "b")
+		server.bump()
+		server.beforePost = nil
+	}
+
+	modifications := 0
+
+	updated, err := ReadModifyWrite(func() (*Customer, error) {
+		return client.FindCustomerById("58")
+	}, func(customer *Customer) error {
+		modifications++
+		customer.Notes = String(*customer.Notes + "c")
+		return nil
+	}, client.UpdateCustomer, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, modifications)
+	assert.Equal(t, String("abc"), updated.Notes)
+	assert.Equal(t, "5", updated.SyncToken)
+}
+
+func TestReadModifyWriteGivesUp(t *testing.T) {
+	server := &customerServer{customer: Customer{Id: "58", SyncToken: "3"}}
+	client := newTestClient(t, server)
+
+	server.beforePost = func(*Customer) {
+		server.bump()
+	}
+
+	_, err := ReadModifyWrite(func() (*Customer, error) {
+		return client.FindCustomerById("58")
+	}, func(customer *Customer) error {
+		customer.DisplayName = "Acme"
+		return nil
+	}, client.UpdateCustomer, 2)
+
+	var conflict *ConflictError
+	assert.True(t, errors.As(err, &conflict))
+	assert.Len(t, server.posts, 2)
+}
+
+func TestFullUpdate(t *testing.T) {
+	server := &customerServer{customer: Customer{
+		Id:          "58",
+		SyncToken:   "3",
+		DisplayName: "Acme",
+		MetaData:    MetaData{CreateTime: &DateTime{time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)}},
+	}}
+	client := newTestClient(t, server)
+
+	customer, err := client.FindCustomerById("58")
+	require.NoError(t, err)
+
+	customer.DisplayName = "Acme Holdings"
+
+	updated, err := client.UpdateCustomer(customer, FullUpdate())
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Holdings", updated.DisplayName)
+
+	require.Len(t, server.posts, 1)
+	assert.JSONEq(t, `false`, string(server.posts[0]["sparse"]))
+}
+
+func TestFullUpdateNeedsFetchedObject(t *testing.T) {
+	server := &customerServer{customer: Customer{Id: "58", SyncToken: "3", DisplayName: "Acme"}}
+	client := newTestClient(t, server)
+
+	_, err := client.UpdateCustomer(&Customer{Id: "58", DisplayName: "Acme Holdings"}, FullUpdate())
+	assert.Error(t, err)
+	assert.Empty(t, server.posts)
+}
+
+func TestFullUpdateKeepsUnmodelledFields(t *testing.T) {
+	const invoice = `{"Invoice": {
+		"Id": "130",
+		"SyncToken": "2",
+		"MetaData": {"CreateTime": "2023-01-02T03:04:05-08:00"},
+		"GlobalTaxCalculation": "TaxExcluded",
+		"DocNumber": "1037",
+		"PrivateNote": "Call first",
+		"CustomerRef": {"value": "3"},
+		"BillAddr": {"Line1": "65 Ocean Dr.", "Line2": "Suite 4", "Unmodelled": "x"},
+		"Line": [
+			{"Id": "1", "Amount": 50, "DetailType": "SalesItemLineDetail", "SalesItemLineDetail": {"ItemRef": {"value": "1"}, "Qty": 2, "TaxClassificationRef": {"value": "EUC-99990201"}}},
+			{"Id": "2", "Amount": 5, "DetailType": "DiscountLineDetail", "DiscountLineDetail": {"PercentBased": false, "DiscountAccountRef": {"value": "86"}}},
+			{"Id": "3", "Amount": 10, "DetailType": "SalesItemLineDetail", "SalesItemLineDetail": {"ItemRef": {"value": "2"}, "TaxClassificationRef": {"value": "EUC-99990201"}}}
+		],
+		"domain": "QBO",
+		"sparse": false
+	}}`
+
+	var posted map[string]json.RawMessage
+	var gets int
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &posted)
+		} else {
+			gets++
+		}
+		w.Write([]byte(invoice))
+	}))
+
+	fetched, err := client.FindInvoiceById("130")
+	require.NoError(t, err)
+
+	fetched.PrivateNote = nil
+	fetched.BillAddr.Line2 = ""
+	fetched.Line[0].SalesItemLineDetail.Qty = "3"
+	fetched.Line = append(fetched.Line[:2], Line{
+		DetailType:          SalesItemLineDetailType,
+		Amount:              "20",
+		SalesItemLineDetail: &SalesItemLineDetail{ItemRef: ReferenceType{Value: "3"}},
+	})
+	fetched.SyncToken = "1"
+	gets = 0
+
+	_, err = client.UpdateInvoice(fetched, FullUpdate())
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `false`, string(posted["sparse"]))
+	assert.JSONEq(t, `"TaxExcluded"`, string(posted["GlobalTaxCalculation"]))
+	assert.JSONEq(t, `"QBO"`, string(posted["domain"]))
+	assert.JSONEq(t, `{"Line1": "65 Ocean Dr.", "Unmodelled": "x"}`, string(posted["BillAddr"]))
+	assert.NotContains(t, posted, "PrivateNote")
+	assert.JSONEq(t, `"1037"`, string(posted["DocNumber"]))
+	assert.JSONEq(t, `"2"`, string(posted["SyncToken"]))
+
+	// Unmodelled fields of a line's detail are kept for the lines that are
+	// still there, matched by Id.
+	assert.JSONEq(t, `[
+		{"Id": "1", "Amount": 50, "DetailType": "SalesItemLineDetail", "SalesItemLineDetail": {"ItemRef": {"value": "1"}, "Qty": 3, "TaxClassificationRef": {"value": "EUC-99990201"}}},
+		{"Id": "2", "Amount": 5, "DetailType": "DiscountLineDetail", "DiscountLineDetail": {"PercentBased": false, "DiscountAccountRef": {"value": "86"}}},
+		{"Amount": 20, "DetailType": "SalesItemLineDetail", "SalesItemLineDetail": {"ItemRef": {"value": "3"}}}
+	]`, string(posted["Line"]))
+
+	// The SyncToken comes from the same read as the unmodelled fields.
+	assert.Equal(t, 1, gets)
+}
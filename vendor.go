@@ -1,7 +1,6 @@
 package quickbooks
 
 import (
-	"encoding/json"
 	"errors"
 	"strconv"
 )
@@ -24,15 +23,15 @@ type Vendor struct {
 	Fax               TelephoneNumber `json:",omitempty"`
 	BusinessNumber    string          `json:",omitempty"`
 	CurrencyRef       ReferenceType   `json:",omitempty"`
-	HasTPAR           bool            `json:",omitempty"`
+	HasTPAR           *bool           `json:",omitempty"`
 	TaxReportingBasis string          `json:",omitempty"`
 	Mobile            TelephoneNumber `json:",omitempty"`
 	PrimaryPhone      TelephoneNumber `json:",omitempty"`
-	Active            bool            `json:",omitempty"`
+	Active            *bool           `json:",omitempty"`
 	AlternatePhone    TelephoneNumber `json:",omitempty"`
 	MetaData          MetaData        `json:",omitempty"`
-	Vendor1099        bool            `json:",omitempty"`
-	BillRate          json.Number     `json:",omitempty"`
+	Vendor1099        *bool           `json:",omitempty"`
+	BillRate          Decimal         `json:",omitempty"`
 	WebAddr           *WebSiteAddress `json:",omitempty"`
 	CompanyName       string          `json:",omitempty"`
 	// VendorPaymentBankDetail
@@ -41,7 +40,7 @@ type Vendor struct {
 	GSTRegistrationType string           `json:",omitempty"`
 	PrintOnCheckName    string           `json:",omitempty"`
 	BillAddr            *PhysicalAddress `json:",omitempty"`
-	Balance             json.Number      `json:",omitempty"`
+	Balance             Decimal          `json:",omitempty"`
 }
 
 // CreateVendor creates the given Vendor on the QuickBooks server, returning
@@ -49,7 +48,7 @@ type Vendor struct {
 func (c *Client) CreateVendor(vendor *Vendor) (*Vendor, error) {
 	var resp struct {
 		Vendor Vendor
-		Time   Date
+		Time   DateTime
 	}
 
 	if err := c.post("vendor", vendor, &resp, nil); err != nil {
@@ -101,7 +100,7 @@ func (c *Client) FindVendors() ([]Vendor, error) {
 func (c *Client) FindVendorById(id string) (*Vendor, error) {
 	var resp struct {
 		Vendor Vendor
-		Time   Date
+		Time   DateTime
 	}
 
 	if err := c.get("vendor/"+id, &resp, nil); err != nil {
@@ -133,34 +132,10 @@ func (c *Client) QueryVendors(query string) ([]Vendor, error) {
 }
 
 // UpdateVendor updates the vendor
-func (c *Client) UpdateVendor(vendor *Vendor) (*Vendor, error) {
+func (c *Client) UpdateVendor(vendor *Vendor, opts ...UpdateOption) (*Vendor, error) {
 	if vendor.Id == "" {
 		return nil, errors.New("missing vendor id")
 	}
 
-	existingVendor, err := c.FindVendorById(vendor.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	vendor.SyncToken = existingVendor.SyncToken
-
-	payload := struct {
-		*Vendor
-		Sparse bool `json:"sparse"`
-	}{
-		Vendor: vendor,
-		Sparse: true,
-	}
-
-	var vendorData struct {
-		Vendor Vendor
-		Time   Date
-	}
-
-	if err = c.post("vendor", payload, &vendorData, nil); err != nil {
-		return nil, err
-	}
-
-	return &vendorData.Vendor, err
+	return update(c, "vendor", "Vendor", vendor, c.FindVendorById, opts)
 }
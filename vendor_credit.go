@@ -0,0 +1,242 @@
+package quickbooks
+
+import (
+	"errors"
+	"strconv"
+)
+
+// VendorCredit represents a credit issued by a vendor, reducing what is owed
+// to them.
+type VendorCredit struct {
+	Id            string        `json:"Id,omitempty"`
+	SyncToken     string        `json:",omitempty"`
+	MetaData      MetaData      `json:",omitempty"`
+	DocNumber     string        `json:",omitempty"`
+	TxnDate       *Date         `json:",omitempty"`
+	PrivateNote   *string       `json:",omitempty"`
+	VendorRef     ReferenceType `json:",omitempty"`
+	APAccountRef  ReferenceType `json:",omitempty"`
+	DepartmentRef ReferenceType `json:",omitempty"`
+	CurrencyRef   ReferenceType `json:",omitempty"`
+	ExchangeRate  Decimal       `json:",omitempty"`
+	Line          []Line
+	LinkedTxn     []LinkedTxn `json:",omitempty"`
+	// GlobalTaxCalculation
+	TxnTaxDetail        TxnTaxDetail   `json:",omitempty"`
+	IncludeInAnnualTPAR *bool          `json:",omitempty"`
+	TotalAmt            Decimal        `json:",omitempty"`
+	Balance             Decimal        `json:",omitempty"`
+	RecurringInfo       *RecurringInfo `json:",omitempty"`
+}
+
+// CreateVendorCredit creates the given VendorCredit on the QuickBooks server,
+// returning the resulting VendorCredit object.
+func (c *Client) CreateVendorCredit(vendorCredit *VendorCredit) (*VendorCredit, error) {
+	var resp struct {
+		VendorCredit VendorCredit
+		Time         DateTime
+	}
+
+	if err := c.post("vendorcredit", vendorCredit, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.VendorCredit, nil
+}
+
+// DeleteVendorCredit deletes the vendor credit
+func (c *Client) DeleteVendorCredit(vendorCredit *VendorCredit) error {
+	if vendorCredit.Id == "" || vendorCredit.SyncToken == "" {
+		return errors.New("missing id/sync token")
+	}
+
+	return c.post("vendorcredit", vendorCredit, nil, map[string]string{"operation": "delete"})
+}
+
+// FindVendorCredits gets the full list of VendorCredits in the QuickBooks account.
+func (c *Client) FindVendorCredits() ([]VendorCredit, error) {
+	var resp struct {
+		QueryResponse struct {
+			VendorCredits []VendorCredit `json:"VendorCredit"`
+			MaxResults    int
+			StartPosition int
+			TotalCount    int
+		}
+	}
+
+	if err := c.query("SELECT COUNT(*) FROM VendorCredit", &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.TotalCount == 0 {
+		return nil, errors.New("no vendor credits could be found")
+	}
+
+	vendorCredits := make([]VendorCredit, 0, resp.QueryResponse.TotalCount)
+
+	for i := 0; i < resp.QueryResponse.TotalCount; i += queryPageSize {
+		query := "SELECT * FROM VendorCredit ORDERBY Id STARTPOSITION " + strconv.Itoa(i+1) + " MAXRESULTS " + strconv.Itoa(queryPageSize)
+
+		if err := c.query(query, &resp); err != nil {
+			return nil, err
+		}
+
+		if resp.QueryResponse.VendorCredits == nil {
+			return nil, errors.New("no vendor credits could be found")
+		}
+
+		vendorCredits = append(vendorCredits, resp.QueryResponse.VendorCredits...)
+	}
+
+	return vendorCredits, nil
+}
+
+// FindVendorCreditById finds the vendor credit by the given id
+func (c *Client) FindVendorCreditById(id string) (*VendorCredit, error) {
+	var resp struct {
+		VendorCredit VendorCredit
+		Time         DateTime
+	}
+
+	if err := c.get("vendorcredit/"+id, &resp, nil); err != nil {
+		return nil, err
+	}
+
+	return &resp.VendorCredit, nil
+}
+
+// QueryVendorCredits accepts an SQL query and returns all vendor credits found using it
+func (c *Client) QueryVendorCredits(query string) ([]VendorCredit, error) {
+	var resp struct {
+		QueryResponse struct {
+			VendorCredits []VendorCredit `json:"VendorCredit"`
+			StartPosition int
+			MaxResults    int
+		}
+	}
+
+	if err := c.query(query, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.QueryResponse.VendorCredits == nil {
+		return nil, errors.New("could not find any vendor credits")
+	}
+
+	return resp.QueryResponse.VendorCredits, nil
+}
+
+// UpdateVendorCredit updates the vendor credit
+func (c *Client) UpdateVendorCredit(vendorCredit *VendorCredit, opts ...UpdateOption) (*VendorCredit, error) {
+	if vendorCredit.Id == "" {
+		return nil, errors.New("missing vendor credit id")
+	}
+
+	return update(c, "vendorcredit", "VendorCredit", vendorCredit, c.FindVendorCreditById, opts)
+}
+
+// ApplyVendorCredits applies the open balance of the given vendor credits
+// against the open balance of the given bills, in the order given. QuickBooks
+// records this as a zero-amount bill payment drawn on bankAccountRef, which is
+// created and returned. All credits and bills must belong to the same vendor.
+func (c *Client) ApplyVendorCredits(bankAccountRef ReferenceType, vendorCredits []VendorCredit, bills []Bill) (*BillPayment, error) {
+	billPayment, err := newVendorCreditBillPayment(bankAccountRef, vendorCredits, bills)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateBillPayment(billPayment)
+}
+
+// newVendorCreditBillPayment builds the bill payment that ApplyVendorCredits
+// posts, allocating each credit to bills until either runs out. TxnDate is
+// left unset so that QuickBooks dates it today in the company's time zone.
+func newVendorCreditBillPayment(bankAccountRef ReferenceType, vendorCredits []VendorCredit, bills []Bill) (*BillPayment, error) {
+	if bankAccountRef.Value == "" {
+		return nil, errors.New("missing bank account")
+	}
+
+	if len(vendorCredits) == 0 || len(bills) == 0 {
+		return nil, errors.New("at least one vendor credit and one bill are required")
+	}
+
+	vendorRef := vendorCredits[0].VendorRef
+
+	creditsLeft := make([]Decimal, len(vendorCredits))
+	for i, vendorCredit := range vendorCredits {
+		if vendorCredit.Id == "" {
+			return nil, errors.New("missing vendor credit id")
+		}
+
+		if vendorCredit.VendorRef.Value != vendorRef.Value {
+			return nil, errors.New("vendor credit " + vendorCredit.Id + " belongs to a different vendor")
+		}
+
+		creditsLeft[i] = vendorCredit.Balance.RoundCurrency()
+	}
+
+	var billLines []BillPaymentLine
+
+	creditsUsed := make([]Decimal, len(vendorCredits))
+	credit := 0
+
+	for _, bill := range bills {
+		if bill.Id == "" {
+			return nil, errors.New("missing bill id")
+		}
+
+		if bill.VendorRef.Value != vendorRef.Value {
+			return nil, errors.New("bill " + bill.Id + " belongs to a different vendor")
+		}
+
+		open := bill.Balance.RoundCurrency()
+
+		var applied Decimal
+
+		for open.Sign() > 0 && credit < len(vendorCredits) {
+			if creditsLeft[credit].Sign() <= 0 {
+				credit++
+				continue
+			}
+
+			amount := open
+			if creditsLeft[credit].Cmp(amount) < 0 {
+				amount = creditsLeft[credit]
+			}
+
+			creditsLeft[credit] = creditsLeft[credit].Sub(amount)
+			creditsUsed[credit] = creditsUsed[credit].Add(amount)
+			open = open.Sub(amount)
+			applied = applied.Add(amount)
+		}
+
+		if applied.Sign() > 0 {
+			billLines = append(billLines, BillPaymentLine{
+				Amount:    applied.RoundCurrency(),
+				LinkedTxn: []LinkedTxn{{TxnID: bill.Id, TxnType: "Bill"}},
+			})
+		}
+	}
+
+	if len(billLines) == 0 {
+		return nil, errors.New("no open balance to apply vendor credits to")
+	}
+
+	lines := billLines
+	for i, used := range creditsUsed {
+		if used.Sign() > 0 {
+			lines = append(lines, BillPaymentLine{
+				Amount:    used.RoundCurrency(),
+				LinkedTxn: []LinkedTxn{{TxnID: vendorCredits[i].Id, TxnType: "VendorCredit"}},
+			})
+		}
+	}
+
+	return &BillPayment{
+		VendorRef:    vendorRef,
+		PayType:      BillPaymentCheckType,
+		CheckPayment: &BillPaymentCheck{BankAccountRef: bankAccountRef},
+		Line:         lines,
+		TotalAmt:     "0",
+	}, nil
+}
@@ -0,0 +1,77 @@
+package quickbooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVendorCredit(t *testing.T) {
+	jsonFile, err := os.Open("data/testing/vendor_credit.json")
+	require.NoError(t, err)
+	defer jsonFile.Close()
+
+	byteValue, err := ioutil.ReadAll(jsonFile)
+	require.NoError(t, err)
+
+	var r struct {
+		VendorCredit VendorCredit
+		Time         DateTime
+	}
+
+	require.NoError(t, json.Unmarshal(byteValue, &r))
+
+	assert.Equal(t, "157", r.VendorCredit.Id)
+	assert.Equal(t, "0", r.VendorCredit.SyncToken)
+	assert.Equal(t, "30", r.VendorCredit.VendorRef.Value)
+	assert.Equal(t, "33", r.VendorCredit.APAccountRef.Value)
+	assert.Equal(t, "2014-12-23", r.VendorCredit.TxnDate.String())
+	assert.Equal(t, Decimal("140.0"), r.VendorCredit.TotalAmt)
+	assert.Equal(t, Decimal("90.0"), r.VendorCredit.Balance)
+	require.Len(t, r.VendorCredit.Line, 2)
+	require.NotNil(t, r.VendorCredit.Line[0].AccountBasedExpenseLineDetail)
+	assert.Equal(t, "8", r.VendorCredit.Line[0].AccountBasedExpenseLineDetail.AccountRef.Value)
+	require.NotNil(t, r.VendorCredit.Line[1].ItemBasedExpenseLineDetail)
+	assert.Equal(t, "11", r.VendorCredit.Line[1].ItemBasedExpenseLineDetail.ItemRef.Value)
+	assert.Equal(t, Decimal("5"), r.VendorCredit.Line[1].ItemBasedExpenseLineDetail.Qty)
+	assert.Equal(t, "2014-12-23T10:07:38-08:00", r.VendorCredit.MetaData.CreateTime.String())
+}
+
+func TestNewVendorCreditBillPayment(t *testing.T) {
+	vendor := ReferenceType{Value: "30"}
+	bank := ReferenceType{Value: "35"}
+
+	credits := []VendorCredit{
+		{Id: "157", VendorRef: vendor, Balance: "90.00"},
+		{Id: "158", VendorRef: vendor, Balance: "25.50"},
+	}
+	bills := []Bill{
+		{Id: "25", VendorRef: vendor, Balance: "100.00"},
+		{Id: "26", VendorRef: vendor, Balance: "0"},
+		{Id: "27", VendorRef: vendor, Balance: "40.00"},
+	}
+
+	billPayment, err := newVendorCreditBillPayment(bank, credits, bills)
+	require.NoError(t, err)
+
+	assert.Nil(t, billPayment.TxnDate)
+	assert.Equal(t, BillPaymentCheckType, billPayment.PayType)
+	assert.Equal(t, "35", billPayment.CheckPayment.BankAccountRef.Value)
+	assert.Equal(t, Decimal("0"), billPayment.TotalAmt)
+
+	b, err := json.Marshal(billPayment)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"TotalAmt":0`)
+	require.Len(t, billPayment.Line, 4)
+	assert.Equal(t, BillPaymentLine{Amount: "100.00", LinkedTxn: []LinkedTxn{{TxnID: "25", TxnType: "Bill"}}}, billPayment.Line[0])
+	assert.Equal(t, BillPaymentLine{Amount: "15.50", LinkedTxn: []LinkedTxn{{TxnID: "27", TxnType: "Bill"}}}, billPayment.Line[1])
+	assert.Equal(t, BillPaymentLine{Amount: "90.00", LinkedTxn: []LinkedTxn{{TxnID: "157", TxnType: "VendorCredit"}}}, billPayment.Line[2])
+	assert.Equal(t, BillPaymentLine{Amount: "25.50", LinkedTxn: []LinkedTxn{{TxnID: "158", TxnType: "VendorCredit"}}}, billPayment.Line[3])
+
+	_, err = newVendorCreditBillPayment(bank, credits, []Bill{{Id: "99", VendorRef: ReferenceType{Value: "46"}, Balance: "10"}})
+	assert.Error(t, err)
+}
@@ -20,12 +20,12 @@ func TestVendor(t *testing.T) {
 
 	var resp struct {
 		Vendor Vendor
-		Time   Date
+		Time   DateTime
 	}
 
 	require.NoError(t, json.Unmarshal(byteValue, &resp))
 	assert.NotNil(t, resp.Vendor.PrimaryEmailAddr)
-	assert.False(t, resp.Vendor.Vendor1099)
+	assert.Equal(t, Bool(false), resp.Vendor.Vendor1099)
 	assert.Equal(t, "Bessie", resp.Vendor.GivenName)
 	assert.Equal(t, "Books by Bessie", resp.Vendor.DisplayName)
 	assert.NotNil(t, resp.Vendor.BillAddr)
@@ -36,7 +36,7 @@ func TestVendor(t *testing.T) {
 	assert.Equal(t, "1345", resp.Vendor.AcctNum)
 	assert.Equal(t, "Books by Bessie", resp.Vendor.CompanyName)
 	assert.NotNil(t, resp.Vendor.WebAddr)
-	assert.True(t, resp.Vendor.Active)
+	assert.Equal(t, Bool(true), resp.Vendor.Active)
 	assert.Equal(t, "0", resp.Vendor.Balance.String())
 	assert.Equal(t, "30", resp.Vendor.Id)
 	assert.Equal(t, "2014-09-12T10:07:56-07:00", resp.Vendor.MetaData.CreateTime.String())